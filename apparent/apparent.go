@@ -0,0 +1,158 @@
+// Reduction of mean catalogue positions of stars to apparent, topocentric
+// and observed places.
+//
+// The corrections are applied in the following order:
+//
+//  1. precession from J2000 to the mean equinox of the date;
+//  2. gravitational deflection of light by the Sun;
+//  3. annual aberration;
+//  4. nutation, giving geocentric apparent place;
+//  5. diurnal aberration, giving topocentric apparent place;
+//  6. atmospheric refraction, giving observed altitude.
+//
+// Proper motion, if any, should be applied to catalogue coordinates
+// beforehand. Parallax of stars is ignored.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, chapters 16, 21, 23.
+package apparent
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/deltat"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/nutequ"
+	"github.com/skrushinsky/scaliger/observer"
+	"github.com/skrushinsky/scaliger/sidereal"
+	"github.com/skrushinsky/scaliger/sun"
+)
+
+// Constant of aberration, arc-degrees.
+const ABERRATION = 20.49552 / 3600
+
+// Diurnal aberration at the equator, arc-degrees.
+const DIURNAL_ABERRATION = 0.3200 / 3600
+
+// Light deflection by the Sun at 1 AU, 2GM/(c²·AU), radians.
+const DEFLECTION = 1.97412574e-8
+
+// Geographical position of the observer.
+type Observer = observer.Observer
+
+// Apparent and observed place.
+type Place struct {
+	// topocentric apparent right ascension, arc-degrees
+	RA float64
+	// topocentric apparent declination, arc-degrees
+	Dec float64
+	// azimuth, arc-degrees, reckoned from the North eastwards
+	Az float64
+	// observed altitude, corrected for refraction, arc-degrees
+	Alt float64
+}
+
+func unit(ra, dec float64) coords.Cartesian {
+	return coords.Spherical{Lon: ra, Lat: dec, R: 1}.Cartesian()
+}
+
+func toEquatorial(c coords.Cartesian) (float64, float64) {
+	s := c.Spherical()
+	return s.Lon, s.Lat
+}
+
+// Given [ra], [dec], mean equatorial coordinates of the date and [jde],
+// calculate coordinates, corrected for nutation and referred to the true
+// equator and equinox of the date.
+func Nutation(ra, dec, jde float64) (float64, float64) {
+	dpsi, deps := nutequ.Nutation(jde)
+	eps := nutequ.MeanObliquity(jde)
+	m := coords.RotationX(-eps - deps).Mul(coords.RotationZ(-dpsi)).Mul(coords.RotationX(eps))
+	return toEquatorial(m.Apply(unit(ra, dec)))
+}
+
+// Given [ra], [dec], equatorial coordinates of the date and [jde],
+// calculate coordinates, corrected for annual aberration, including the
+// terms depending on eccentricity of the Earth orbit.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, formula 23.3.
+func Aberration(ra, dec, jde float64) (float64, float64) {
+	t := (jde - julian.J2000) / julian.DAYS_PER_CENT
+	sl, cl := math.Sincos(mathutils.Radians(sun.Geometric(jde, sun.HighAccuracy).Lon))
+	e := mathutils.Polynome(t, 0.016708634, -0.000042037, -0.0000001267)
+	sp, cp := math.Sincos(mathutils.Radians(mathutils.Polynome(t, 102.93735, 1.71946, 0.00046)))
+	eps := mathutils.Radians(nutequ.MeanObliquity(jde))
+	ce := math.Cos(eps)
+	sa, ca := math.Sincos(mathutils.Radians(ra))
+	sd, cd := math.Sincos(mathutils.Radians(dec))
+	k := ABERRATION
+
+	da := (-k*(ca*cl*ce+sa*sl) + e*k*(ca*cp*ce+sa*sp)) / cd
+	q := math.Tan(eps)*cd - sa*sd
+	dd := -k*(cl*ce*q+ca*sd*sl) + e*k*(cp*ce*q+ca*sd*sp)
+	return mathutils.ReduceDeg(ra + da), dec + dd
+}
+
+// Given [ra], [dec], equatorial coordinates of the date and [jde],
+// calculate coordinates, corrected for gravitational deflection of light
+// by the Sun. The effect is 1.75″ at the solar limb and 0.004″ at 90° from
+// the Sun.
+func Deflection(ra, dec, jde float64) (float64, float64) {
+	s := sun.Geometric(jde, sun.HighAccuracy)
+	eps := nutequ.MeanObliquity(jde)
+	sv := coords.EclipticToEquatorialMatrix(eps).Apply(coords.Spherical{Lon: s.Lon, Lat: s.Lat, R: 1}.Cartesian())
+	// unit vector from the Sun to the Earth
+	e := coords.Cartesian{X: -sv.X, Y: -sv.Y, Z: -sv.Z}
+	p := unit(ra, dec)
+	pe := p.X*e.X + p.Y*e.Y + p.Z*e.Z
+	g := DEFLECTION / s.R / (1 + pe)
+	p = coords.Cartesian{X: p.X + g*(e.X-pe*p.X), Y: p.Y + g*(e.Y-pe*p.Y), Z: p.Z + g*(e.Z-pe*p.Z)}
+	return toEquatorial(p)
+}
+
+// Given [ra], [dec], geocentric apparent coordinates, [ha], local hour
+// angle and [obs], observer's position, calculate coordinates, corrected
+// for diurnal aberration.
+func DiurnalAberration(ra, dec, ha float64, obs Observer) (float64, float64) {
+	_, rhoCos := obs.RhoPhi()
+	k := DIURNAL_ABERRATION * rhoCos
+	sh, ch := math.Sincos(mathutils.Radians(ha))
+	sd, cd := math.Sincos(mathutils.Radians(dec))
+	return mathutils.ReduceDeg(ra + k*ch/cd), dec + k*sh*sd
+}
+
+// Given [ra], [dec], mean equatorial coordinates, J2000 and [jde], calculate
+// geocentric apparent coordinates, referred to the true equator and
+// equinox of the date.
+func Geocentric(ra, dec, jde float64) (float64, float64) {
+	ra, dec = coords.Precess(ra, dec, julian.J2000, jde)
+	ra, dec = Deflection(ra, dec, jde)
+	ra, dec = Aberration(ra, dec, jde)
+	return Nutation(ra, dec, jde)
+}
+
+func localSidereal(jd float64, obs Observer) float64 {
+	return sidereal.JulianToSidereal(jd, obs.SiderealOptions())
+}
+
+// Given [ra], [dec], mean equatorial coordinates, J2000, [jd], UT Julian
+// Date and [obs], observer's position, calculate topocentric apparent
+// coordinates.
+func Topocentric(ra, dec, jd float64, obs Observer) (float64, float64) {
+	jde := jd + deltat.DeltaT(jd)/julian.SEC_PER_DAY
+	ra, dec = Geocentric(ra, dec, jde)
+	return DiurnalAberration(ra, dec, coords.HourAngleDeg(localSidereal(jd, obs), ra), obs)
+}
+
+// Given [ra], [dec], mean equatorial coordinates, J2000, [jd], UT Julian
+// Date, [obs], observer's position and [weather], calculate topocentric
+// apparent place and observed horizontal coordinates.
+//
+//	obs := Observer{Lng: 37.5833, Lat: 55.75, Height: 150}
+//	place := Observe(ra, dec, jd, obs, STANDARD_WEATHER)
+func Observe(ra, dec, jd float64, obs Observer, weather Weather) Place {
+	ra, dec = Topocentric(ra, dec, jd, obs)
+	az, alt := coords.EquatorialToHorizontal(coords.HourAngleDeg(localSidereal(jd, obs), ra), dec, obs.Lat)
+	return Place{RA: ra, Dec: dec, Az: az, Alt: alt + Refraction(alt, weather)}
+}
@@ -0,0 +1,79 @@
+package apparent
+
+import (
+	"testing"
+
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/nutequ"
+	"github.com/skrushinsky/scaliger/sun"
+)
+
+// One arc-second, in degrees
+const _ARCSEC = 1.0 / 3600
+
+func TestGeocentric(t *testing.T) {
+	// Meeus, example 23.a, θ Persei, 2028 Nov 13.19 TD, proper motion applied
+	ra, dec := Geocentric(41.054063, 49.227750, 2462088.69)
+	if !mathutils.AlmostEqual(ra, 41.5599646, 0.5*_ARCSEC) {
+		t.Errorf("Expected: %f, got: %f", 41.5599646, ra)
+	}
+	if !mathutils.AlmostEqual(dec, 49.3520685, 0.5*_ARCSEC) {
+		t.Errorf("Expected: %f, got: %f", 49.3520685, dec)
+	}
+}
+
+func TestAberration(t *testing.T) {
+	// Meeus, example 23.a: Δα2 = +30.045″, Δδ2 = +6.697″
+	ra, dec := Aberration(41.547214, 49.348483, 2462088.69)
+	if !mathutils.AlmostEqual((ra-41.547214)*3600, 30.045, 0.01) {
+		t.Errorf("Expected: %f, got: %f", 30.045, (ra-41.547214)*3600)
+	}
+	if !mathutils.AlmostEqual((dec-49.348483)*3600, 6.697, 0.01) {
+		t.Errorf("Expected: %f, got: %f", 6.697, (dec-49.348483)*3600)
+	}
+}
+
+func TestDeflection(t *testing.T) {
+	jde := julian.J2000
+	s := sun.Geometric(jde, sun.HighAccuracy)
+	sra, sdec := coords.EclipticToEquatorial(s.Lon, s.Lat, nutequ.MeanObliquity(jde))
+	// a star 1° north of the Sun is displaced outwards by 0.00407″·cot(0.5°)/R,
+	// R = 0.9833 AU at J2000
+	ra, dec := Deflection(sra, sdec+1, jde)
+	got := (coords.AngularSeparation(ra, dec, sra, sdec) - 1) * 3600
+	if !mathutils.AlmostEqual(got, 0.474, 0.002) {
+		t.Errorf("Expected: %f, got: %f", 0.474, got)
+	}
+}
+
+func TestDiurnalAberration(t *testing.T) {
+	// on the equator, at the meridian
+	ra, dec := DiurnalAberration(100, 0, 0, Observer{})
+	if !mathutils.AlmostEqual((ra-100)*3600, 0.32, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 0.32, (ra-100)*3600)
+	}
+	if dec != 0 {
+		t.Errorf("Expected: %f, got: %f", 0.0, dec)
+	}
+}
+
+func TestObserve(t *testing.T) {
+	obs := Observer{Lng: 37.5833, Lat: 55.75, Height: 150}
+	jd := 2460000.5
+	place := Observe(279.23473479, 38.78368896, jd, obs, STANDARD_WEATHER) // Vega
+	ra, dec := Topocentric(279.23473479, 38.78368896, jd, obs)
+	if place.RA != ra || place.Dec != dec {
+		t.Errorf("Expected: %f %f, got: %f %f", ra, dec, place.RA, place.Dec)
+	}
+	airless := Observe(279.23473479, 38.78368896, jd, obs, Weather{Temperature: 10})
+	got := place.Alt - airless.Alt
+	exp := Refraction(airless.Alt, STANDARD_WEATHER)
+	if !mathutils.AlmostEqual(got, exp, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+	if !mathutils.AlmostEqual(place.Az, airless.Az, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", airless.Az, place.Az)
+	}
+}
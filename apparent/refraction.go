@@ -0,0 +1,50 @@
+package apparent
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// Lowest altitude, arc-degrees, for which the refraction formulae are
+// evaluated; below it refraction is assumed constant.
+const _MIN_ALTITUDE = -1.0
+
+// Meteorological conditions at the observer's site.
+type Weather struct {
+	// air temperature, degrees Celsius
+	Temperature float64
+	// atmospheric pressure, millibars
+	Pressure float64
+}
+
+// Conditions for which the refraction formulae were derived.
+var STANDARD_WEATHER = Weather{Temperature: 10, Pressure: 1010}
+
+// Refraction in arc-minutes is multiplied by this factor.
+func (w Weather) factor() float64 {
+	return w.Pressure / 1010 * 283 / (273 + w.Temperature)
+}
+
+// Given [alt], true (airless) altitude in arc-degrees and [weather],
+// calculate atmospheric refraction in arc-degrees, Saemundsson's formula.
+// Observed altitude is alt + Refraction(alt, weather).
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, formula 16.4.
+func Refraction(alt float64, weather Weather) float64 {
+	h := math.Max(alt, _MIN_ALTITUDE)
+	r := 1.02/math.Tan(mathutils.Radians(h+10.3/(h+5.11))) + 0.0019279
+	return math.Max(r, 0) * weather.factor() / 60
+}
+
+// Given [alt], observed altitude in arc-degrees and [weather], calculate
+// atmospheric refraction in arc-degrees, Bennett's formula. True altitude
+// is alt - RefractionObserved(alt, weather).
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, formula 16.3.
+func RefractionObserved(alt float64, weather Weather) float64 {
+	h := math.Max(alt, _MIN_ALTITUDE)
+	r := 1 / math.Tan(mathutils.Radians(h+7.31/(h+4.4)))
+	r -= 0.06 * math.Sin(mathutils.Radians(14.7*r+13))
+	return math.Max(r, 0) * weather.factor() / 60
+}
@@ -0,0 +1,47 @@
+package apparent
+
+import (
+	"testing"
+
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+func TestRefractionObserved(t *testing.T) {
+	cases := []struct {
+		alt float64
+		exp float64 // arc-minutes
+	}{
+		{alt: 0, exp: 34.5},
+		{alt: 10, exp: 5.3},
+		{alt: 45, exp: 0.97},
+		{alt: 90, exp: 0},
+	}
+	for _, test := range cases {
+		got := RefractionObserved(test.alt, STANDARD_WEATHER) * 60
+		if !mathutils.AlmostEqual(got, test.exp, 0.05) {
+			t.Errorf("Expected: %f, got: %f", test.exp, got)
+		}
+	}
+}
+
+func TestRefractionInverse(t *testing.T) {
+	// Saemundsson's formula agrees with Bennett's within 0.1′ above 3°
+	for _, h := range []float64{3, 10, 30, 60} {
+		r := RefractionObserved(h, STANDARD_WEATHER)
+		got := Refraction(h-r, STANDARD_WEATHER)
+		if !mathutils.AlmostEqual(got*60, r*60, 0.15) {
+			t.Errorf("Expected: %f, got: %f", r*60, got*60)
+		}
+	}
+}
+
+func TestRefractionWeather(t *testing.T) {
+	if got := Refraction(10, Weather{Temperature: 10, Pressure: 0}); got != 0 {
+		t.Errorf("Expected: %f, got: %f", 0.0, got)
+	}
+	cold := Refraction(10, Weather{Temperature: -20, Pressure: 1010})
+	warm := Refraction(10, Weather{Temperature: 30, Pressure: 1010})
+	if cold <= warm {
+		t.Errorf("Expected cold air refraction %f to exceed %f", cold, warm)
+	}
+}
@@ -0,0 +1,97 @@
+package coords
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// Rectangular coordinates.
+type Cartesian struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+// Spherical coordinates.
+type Spherical struct {
+	// longitude, arc-degrees, 0 >= x < 360
+	Lon float64
+	// latitude, arc-degrees, -90 >= x <= 90
+	Lat float64
+	// radius vector, 1 for directions
+	R float64
+}
+
+// Converts spherical coordinates to rectangular.
+func (s Spherical) Cartesian() Cartesian {
+	lon := mathutils.Radians(s.Lon)
+	lat := mathutils.Radians(s.Lat)
+	rcb := s.R * math.Cos(lat)
+	return Cartesian{X: rcb * math.Cos(lon), Y: rcb * math.Sin(lon), Z: s.R * math.Sin(lat)}
+}
+
+// Converts rectangular coordinates to spherical.
+func (c Cartesian) Spherical() Spherical {
+	r := math.Sqrt(c.X*c.X + c.Y*c.Y + c.Z*c.Z)
+	if r == 0 {
+		return Spherical{}
+	}
+	lon := mathutils.ReduceDeg(mathutils.Degrees(math.Atan2(c.Y, c.X)))
+	lat := mathutils.Degrees(math.Asin(c.Z / r))
+	return Spherical{Lon: lon, Lat: lat, R: r}
+}
+
+// Rotation matrix.
+type Matrix [3][3]float64
+
+// Applies the matrix to rectangular coordinates.
+func (m Matrix) Apply(c Cartesian) Cartesian {
+	return Cartesian{
+		X: m[0][0]*c.X + m[0][1]*c.Y + m[0][2]*c.Z,
+		Y: m[1][0]*c.X + m[1][1]*c.Y + m[1][2]*c.Z,
+		Z: m[2][0]*c.X + m[2][1]*c.Y + m[2][2]*c.Z,
+	}
+}
+
+// Product of two matrices, m·n: n is applied first.
+func (m Matrix) Mul(n Matrix) Matrix {
+	var r Matrix
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				r[i][j] += m[i][k] * n[k][j]
+			}
+		}
+	}
+	return r
+}
+
+// Transposed matrix. For a rotation matrix it is the inverse one.
+func (m Matrix) Transpose() Matrix {
+	var r Matrix
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i][j] = m[j][i]
+		}
+	}
+	return r
+}
+
+// Matrix rotating the coordinate frame about the X axis by [deg] arc-degrees.
+func RotationX(deg float64) Matrix {
+	s, c := math.Sincos(mathutils.Radians(deg))
+	return Matrix{{1, 0, 0}, {0, c, s}, {0, -s, c}}
+}
+
+// Matrix rotating the coordinate frame about the Y axis by [deg] arc-degrees.
+func RotationY(deg float64) Matrix {
+	s, c := math.Sincos(mathutils.Radians(deg))
+	return Matrix{{c, 0, -s}, {0, 1, 0}, {s, 0, c}}
+}
+
+// Matrix rotating the coordinate frame about the Z axis by [deg] arc-degrees.
+func RotationZ(deg float64) Matrix {
+	s, c := math.Sincos(mathutils.Radians(deg))
+	return Matrix{{c, s, 0}, {-s, c, 0}, {0, 0, 1}}
+}
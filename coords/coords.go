@@ -0,0 +1,174 @@
+// Transformations between equatorial, ecliptic, horizontal and galactic
+// coordinates, in spherical and rectangular forms.
+//
+// All angles are in arc-degrees, including right ascension. Azimuth is
+// reckoned from the North point eastwards.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, chapters 13, 17.
+package coords
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/sidereal"
+)
+
+// North galactic pole and galactic longitude of the north celestial pole, J2000.
+const (
+	_GAL_POLE_RA  = 192.85948
+	_GAL_POLE_DEC = 27.12825
+	_GAL_NCP_LON  = 122.93192
+)
+
+// Rotation from equatorial J2000 to galactic rectangular coordinates.
+var _GAL_MATRIX = Matrix{
+	{-0.0548755604, -0.8734370902, -0.4838350155},
+	{0.4941094279, -0.4448296300, 0.7469822445},
+	{-0.8676661490, -0.1980763734, 0.4559837762},
+}
+
+func sincos(deg float64) (float64, float64) {
+	return math.Sincos(mathutils.Radians(deg))
+}
+
+func atan2Deg(y, x float64) float64 {
+	return mathutils.ReduceDeg(mathutils.Degrees(math.Atan2(y, x)))
+}
+
+func asinDeg(x float64) float64 {
+	return mathutils.Degrees(math.Asin(math.Max(-1, math.Min(1, x))))
+}
+
+// Given [ra], [dec], equatorial coordinates and [eps], obliquity of the
+// ecliptic, calculate ecliptic longitude and latitude.
+//
+// Use nutequ.MeanObliquity for mean coordinates and nutequ.TrueObliquity
+// for apparent ones.
+func EquatorialToEcliptic(ra, dec, eps float64) (lon float64, lat float64) {
+	sa, ca := sincos(ra)
+	sd, cd := sincos(dec)
+	se, ce := sincos(eps)
+	lon = atan2Deg(sa*ce+sd/cd*se, ca)
+	lat = asinDeg(sd*ce - cd*se*sa)
+	return
+}
+
+// Given [lon], [lat], ecliptic coordinates and [eps], obliquity of the
+// ecliptic, calculate right ascension and declination.
+func EclipticToEquatorial(lon, lat, eps float64) (ra float64, dec float64) {
+	sl, cl := sincos(lon)
+	sb, cb := sincos(lat)
+	se, ce := sincos(eps)
+	ra = atan2Deg(sl*ce-sb/cb*se, cl)
+	dec = asinDeg(sb*ce + cb*se*sl)
+	return
+}
+
+// Given [lst], local sidereal time in hours, as returned by
+// sidereal.JulianToSidereal and [ra], right ascension in arc-degrees,
+// calculate local hour angle in arc-degrees, 0 >= x < 360, positive
+// westwards. Note that sidereal.HourAngle returns hours, -12 > x <= 12.
+func HourAngleDeg(lst, ra float64) float64 {
+	return mathutils.ReduceDeg(lst*15 - ra)
+}
+
+// Given [ha], local hour angle, [dec], declination and [lat], observer's
+// geographical latitude, calculate azimuth and altitude.
+func EquatorialToHorizontal(ha, dec, lat float64) (az float64, alt float64) {
+	sh, ch := sincos(ha)
+	sd, cd := sincos(dec)
+	sp, cp := sincos(lat)
+	az = atan2Deg(-cd*sh, sd*cp-cd*sp*ch)
+	alt = asinDeg(sp*sd + cp*cd*ch)
+	return
+}
+
+// Given [az], azimuth, [alt], altitude and [lat], observer's geographical
+// latitude, calculate local hour angle and declination.
+func HorizontalToEquatorial(az, alt, lat float64) (ha float64, dec float64) {
+	sa, ca := sincos(az)
+	sh, ch := sincos(alt)
+	sp, cp := sincos(lat)
+	ha = atan2Deg(-ch*sa, sh*cp-ch*sp*ca)
+	dec = asinDeg(sp*sh + cp*ch*ca)
+	return
+}
+
+// Given [jd], Julian Date, [ra], [dec], equatorial coordinates and [lat],
+// observer's latitude, calculate azimuth and altitude. Options are passed
+// to sidereal.JulianToSidereal; Lng field should contain observer's longitude.
+func EquatorialToHorizontalAt(jd, ra, dec, lat float64, options sidereal.SiderealOptions) (az float64, alt float64) {
+	lst := sidereal.JulianToSidereal(jd, options)
+	return EquatorialToHorizontal(HourAngleDeg(lst, ra), dec, lat)
+}
+
+// Given [ra], [dec], equatorial coordinates, J2000, calculate galactic
+// longitude and latitude.
+func EquatorialToGalactic(ra, dec float64) (l float64, b float64) {
+	sa, ca := sincos(ra - _GAL_POLE_RA)
+	sd, cd := sincos(dec)
+	sg, cg := sincos(_GAL_POLE_DEC)
+	b = asinDeg(sd*sg + cd*cg*ca)
+	l = mathutils.ReduceDeg(_GAL_NCP_LON - atan2Deg(cd*sa, sd*cg-cd*sg*ca))
+	return
+}
+
+// Given [l], [b], galactic coordinates, calculate right ascension and
+// declination, J2000.
+func GalacticToEquatorial(l, b float64) (ra float64, dec float64) {
+	sl, cl := sincos(_GAL_NCP_LON - l)
+	sb, cb := sincos(b)
+	sg, cg := sincos(_GAL_POLE_DEC)
+	dec = asinDeg(sb*sg + cb*cg*cl)
+	ra = mathutils.ReduceDeg(_GAL_POLE_RA + atan2Deg(cb*sl, sb*cg-cb*sg*cl))
+	return
+}
+
+// Matrix converting ecliptic rectangular coordinates to equatorial ones,
+// given [eps], obliquity of the ecliptic. Transposed matrix performs the
+// reverse conversion.
+func EclipticToEquatorialMatrix(eps float64) Matrix {
+	return RotationX(-eps)
+}
+
+// Matrix converting equatorial rectangular coordinates to horizontal ones,
+// given [lst], local sidereal time in hours and [lat], observer's latitude.
+// Horizontal X axis points to the North, Y axis to the East and Z axis to
+// the zenith, so that spherical longitude of the result is azimuth.
+// Transposed matrix performs the reverse conversion.
+func EquatorialToHorizontalMatrix(lst, lat float64) Matrix {
+	st, ct := sincos(lst * 15)
+	sp, cp := sincos(lat)
+	toHourAngle := Matrix{{ct, st, 0}, {st, -ct, 0}, {0, 0, 1}}
+	toHorizontal := Matrix{{-sp, 0, cp}, {0, -1, 0}, {cp, 0, sp}}
+	return toHorizontal.Mul(toHourAngle)
+}
+
+// Matrix converting equatorial J2000 rectangular coordinates to galactic
+// ones. Transposed matrix performs the reverse conversion.
+func EquatorialToGalacticMatrix() Matrix {
+	return _GAL_MATRIX
+}
+
+// Given equatorial coordinates of two points, calculate angular separation
+// between them in arc-degrees.
+func AngularSeparation(ra1, dec1, ra2, dec2 float64) float64 {
+	sa, ca := sincos(ra2 - ra1)
+	sd1, cd1 := sincos(dec1)
+	sd2, cd2 := sincos(dec2)
+	x := cd1*sd2 - sd1*cd2*ca
+	y := cd2 * sa
+	z := sd1*sd2 + cd1*cd2*ca
+	return mathutils.Degrees(math.Atan2(math.Hypot(x, y), z))
+}
+
+// Given equatorial coordinates of two points, calculate position angle of
+// the second point relative to the first one, 0 >= x < 360, reckoned from
+// the North through the East.
+func PositionAngle(ra1, dec1, ra2, dec2 float64) float64 {
+	sa, ca := sincos(ra2 - ra1)
+	sd1, cd1 := sincos(dec1)
+	sd2, cd2 := sincos(dec2)
+	return atan2Deg(cd2*sa, cd1*sd2-sd1*cd2*ca)
+}
@@ -0,0 +1,115 @@
+package coords
+
+import (
+	"testing"
+
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+func TestEquatorialToEcliptic(t *testing.T) {
+	// Meeus, example 13.a, Pollux
+	lon, lat := EquatorialToEcliptic(116.328942, 28.026183, 23.4392911)
+	if !mathutils.AlmostEqual(lon, 113.215630, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 113.215630, lon)
+	}
+	if !mathutils.AlmostEqual(lat, 6.684170, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 6.684170, lat)
+	}
+}
+
+func TestEclipticToEquatorial(t *testing.T) {
+	ra, dec := EclipticToEquatorial(113.215630, 6.684170, 23.4392911)
+	if !mathutils.AlmostEqual(ra, 116.328942, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 116.328942, ra)
+	}
+	if !mathutils.AlmostEqual(dec, 28.026183, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 28.026183, dec)
+	}
+}
+
+func TestEquatorialToHorizontal(t *testing.T) {
+	// Meeus, example 13.b, Venus at Washington; Meeus reckons azimuth from the South
+	az, alt := EquatorialToHorizontal(64.352133, -6.719892, 38.921389)
+	if !mathutils.AlmostEqual(az, 68.0337+180, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", 68.0337+180, az)
+	}
+	if !mathutils.AlmostEqual(alt, 15.1249, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", 15.1249, alt)
+	}
+	ha, dec := HorizontalToEquatorial(az, alt, 38.921389)
+	if !mathutils.AlmostEqual(ha, 64.352133, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 64.352133, ha)
+	}
+	if !mathutils.AlmostEqual(dec, -6.719892, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", -6.719892, dec)
+	}
+}
+
+func TestGalactic(t *testing.T) {
+	// galactic center
+	ra, dec := GalacticToEquatorial(0, 0)
+	if !mathutils.AlmostEqual(ra, 266.40500, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", 266.40500, ra)
+	}
+	if !mathutils.AlmostEqual(dec, -28.93617, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", -28.93617, dec)
+	}
+	l, b := EquatorialToGalactic(ra, dec)
+	if !mathutils.AlmostEqual(mathutils.ReduceDeg(l+180), 180, 1e-6) || !mathutils.AlmostEqual(b, 0, 1e-6) {
+		t.Errorf("Expected: 0, 0, got: %f, %f", l, b)
+	}
+}
+
+func TestGalacticMatrix(t *testing.T) {
+	ra, dec := 116.328942, 28.026183
+	l, b := EquatorialToGalactic(ra, dec)
+	got := EquatorialToGalacticMatrix().Apply(Spherical{Lon: ra, Lat: dec, R: 1}.Cartesian()).Spherical()
+	if !mathutils.AlmostEqual(got.Lon, l, 1e-5) || !mathutils.AlmostEqual(got.Lat, b, 1e-5) {
+		t.Errorf("Expected: %f, %f, got: %f, %f", l, b, got.Lon, got.Lat)
+	}
+}
+
+func TestEclipticMatrix(t *testing.T) {
+	ecl := Spherical{Lon: 113.215630, Lat: 6.684170, R: 2.5}
+	got := EclipticToEquatorialMatrix(23.4392911).Apply(ecl.Cartesian()).Spherical()
+	if !mathutils.AlmostEqual(got.Lon, 116.328942, 1e-6) || !mathutils.AlmostEqual(got.Lat, 28.026183, 1e-6) {
+		t.Errorf("Expected: %f, %f, got: %f, %f", 116.328942, 28.026183, got.Lon, got.Lat)
+	}
+	if !mathutils.AlmostEqual(got.R, ecl.R, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", ecl.R, got.R)
+	}
+	back := EclipticToEquatorialMatrix(23.4392911).Transpose().Apply(got.Cartesian()).Spherical()
+	if !mathutils.AlmostEqual(back.Lon, ecl.Lon, 1e-6) || !mathutils.AlmostEqual(back.Lat, ecl.Lat, 1e-6) {
+		t.Errorf("Expected: %f, %f, got: %f, %f", ecl.Lon, ecl.Lat, back.Lon, back.Lat)
+	}
+}
+
+func TestHorizontalMatrix(t *testing.T) {
+	lst := 10.5
+	ra, dec, lat := 100.0, -6.719892, 38.921389
+	az, alt := EquatorialToHorizontal(HourAngleDeg(lst, ra), dec, lat)
+	got := EquatorialToHorizontalMatrix(lst, lat).Apply(Spherical{Lon: ra, Lat: dec, R: 1}.Cartesian()).Spherical()
+	if !mathutils.AlmostEqual(got.Lon, az, 1e-9) || !mathutils.AlmostEqual(got.Lat, alt, 1e-9) {
+		t.Errorf("Expected: %f, %f, got: %f, %f", az, alt, got.Lon, got.Lat)
+	}
+}
+
+func TestAngularSeparation(t *testing.T) {
+	// Meeus, example 17.a, Arcturus and Spica
+	got := AngularSeparation(213.9154, 19.1825, 201.2983, -11.1614)
+	if !mathutils.AlmostEqual(got, 32.7930, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", 32.7930, got)
+	}
+}
+
+func TestPositionAngle(t *testing.T) {
+	if got := PositionAngle(10, 20, 10, 21); !mathutils.AlmostEqual(got, 0, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", 0.0, got)
+	}
+	if got := PositionAngle(10, 0, 11, 0); !mathutils.AlmostEqual(got, 90, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", 90.0, got)
+	}
+	if got := PositionAngle(10, 0, 9, 0); !mathutils.AlmostEqual(got, 270, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", 270.0, got)
+	}
+}
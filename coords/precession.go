@@ -0,0 +1,43 @@
+package coords
+
+import (
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// Given [jde0], starting epoch and [jde], final epoch, calculate precession
+// angles zeta, z and theta in arc-degrees, IAU 1976 model.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, formula 21.2.
+func PrecessionAngles(jde0, jde float64) (zeta float64, z float64, theta float64) {
+	T := (jde0 - julian.J2000) / julian.DAYS_PER_CENT
+	t := (jde - jde0) / julian.DAYS_PER_CENT
+	c := mathutils.Polynome(T, 2306.2181, 1.39656, -0.000139)
+	zeta = mathutils.Polynome(t, 0, c, 0.30188-0.000344*T, 0.017998) / 3600
+	z = mathutils.Polynome(t, 0, c, 1.09468+0.000066*T, 0.018203) / 3600
+	theta = mathutils.Polynome(t, 0, mathutils.Polynome(T, 2004.3109, -0.85330, -0.000217), -0.42665-0.000217*T, -0.041833) / 3600
+	return
+}
+
+// Matrix converting equatorial rectangular coordinates, referred to the
+// mean equator and equinox of [jde0], to the mean equator and equinox of
+// [jde]. Transposed matrix performs the reverse conversion.
+func PrecessionMatrix(jde0, jde float64) Matrix {
+	zeta, z, theta := PrecessionAngles(jde0, jde)
+	return RotationZ(-z).Mul(RotationY(theta)).Mul(RotationZ(-zeta))
+}
+
+// Given [ra], [dec], mean equatorial coordinates, referred to epoch
+// [jde0], calculate mean coordinates for epoch [jde]. Proper motion,
+// if any, should be applied beforehand.
+//
+//	// J2000 to the equinox of the date
+//	ra, dec = Precess(ra, dec, julian.J2000, jde)
+func Precess(ra, dec, jde0, jde float64) (float64, float64) {
+	if jde0 == jde {
+		return ra, dec
+	}
+	c := PrecessionMatrix(jde0, jde).Apply(Spherical{Lon: ra, Lat: dec, R: 1}.Cartesian())
+	s := c.Spherical()
+	return s.Lon, s.Lat
+}
@@ -0,0 +1,29 @@
+package coords
+
+import (
+	"testing"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+func TestPrecess(t *testing.T) {
+	// Meeus, example 21.b, θ Persei, proper motion already applied
+	ra, dec := Precess(41.054063, 49.227750, julian.J2000, 2462088.69)
+	if !mathutils.AlmostEqual(ra, 41.547214, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 41.547214, ra)
+	}
+	if !mathutils.AlmostEqual(dec, 49.348483, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 49.348483, dec)
+	}
+}
+
+func TestPrecessBack(t *testing.T) {
+	ra, dec := Precess(41.547214, 49.348483, 2462088.69, julian.J2000)
+	if !mathutils.AlmostEqual(ra, 41.054063, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 41.054063, ra)
+	}
+	if !mathutils.AlmostEqual(dec, 49.227750, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 49.227750, dec)
+	}
+}
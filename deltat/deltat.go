@@ -228,11 +228,20 @@ var _HISTORICAL = map[int]float64{
 const _TAB_SINCE = 1620
 const _TAB_UNTIL = 2016
 
+// Historical values as an evenly spaced table, the argument is year.
+var _TABLE = func() mathutils.EvenTable {
+	tab := mathutils.EvenTable{X0: _TAB_SINCE, Step: 2}
+	for y := _TAB_SINCE; y <= _TAB_UNTIL; y += 2 {
+		tab.Y = append(tab.Y, _HISTORICAL[y])
+	}
+	return tab
+}()
+
 // For a historical range from 1620 to a recent year, we interpolate from a
 // table of observed values. Outside that range we use formulae.
 func interpolate(year int, jd float64) float64 {
 	if year == _TAB_UNTIL {
-		// the last value in the table, np interpolation
+		// the last value in the table, no interpolation
 		return _HISTORICAL[_TAB_UNTIL]
 	}
 	var y0 int
@@ -241,13 +250,11 @@ func interpolate(year int, jd float64) float64 {
 	} else {
 		y0 = year - 1
 	}
-	y1 := y0 + 2
-	d0 := _HISTORICAL[y0]
-	d1 := _HISTORICAL[y1]
 	j0 := julian.JulianDateZero(y0)
-	j1 := julian.JulianDateZero(y1)
-	// simple linear interpolation between two values
-	return ((jd - j0) * (d1 - d0) / (j1 - j0)) + d0
+	j1 := julian.JulianDateZero(y0 + 2)
+	// fractional year
+	x := float64(y0) + 2*(jd-j0)/(j1-j0)
+	return _TABLE.Everett(x)
 }
 
 func predict(year int) float64 {
@@ -14,6 +14,7 @@ type _DeltaTTestCase struct {
 var cases = [...]_DeltaTTestCase{
 	{jd: 2312873.5, dt: 119.5},   // 1620-05-01, historical start 2312873.5
 	{jd: 2068318.5, dt: 1820.33}, // # 950-10-01, after 948 2068318.5
+	{jd: 2415020.5, dt: -2.80},   // 1900-01-01, historical
 	{jd: 2459040.5, dt: 93.81},   // 2020-07-10, after 2010 2459040.5
 	{jd: 2524602.5, dt: 407.2},   // after 2100 2524602.5
 }
@@ -3,12 +3,14 @@
 //
 //	cal2jd DATE
 //
-// DATE is a civil date in RFC3339 format, e.g. 2023-04-13T06:00:00Z
+// DATE is a civil date in RFC3339 format, e.g. 2023-04-13T06:00:00Z,
+// or any other format understood by julian.ParseDate, e.g. 1900 Jan 0.5
 package main
 
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/skrushinsky/scaliger/julian"
@@ -18,14 +20,14 @@ func main() {
 	var dateStr string
 
 	if len(os.Args) > 1 {
-		dateStr = os.Args[1]
+		dateStr = strings.Join(os.Args[1:], " ")
 	} else {
 		dateStr = time.Now().Format(time.RFC3339)
 	}
 
 	jd, error := julian.DateStringToJulian(dateStr)
 	if error != nil {
-		fmt.Printf("Invalid date: %s\n. Please, use format: y-mm-ddThh:mm:ssZ", dateStr)
+		fmt.Printf("Invalid date: %s\n%v\n", dateStr, error)
 		os.Exit(1)
 	} else {
 		fmt.Printf("%.8f\n", jd)
@@ -12,10 +12,8 @@ import (
 	"os"
 	"time"
 
-	"github.com/skrushinsky/scaliger/deltat"
 	"github.com/skrushinsky/scaliger/julian"
 	"github.com/skrushinsky/scaliger/mathutils"
-	"github.com/skrushinsky/scaliger/nutequ"
 	"github.com/skrushinsky/scaliger/sidereal"
 )
 
@@ -30,14 +28,10 @@ func main() {
 		fmt.Printf("Invalid date: %s\n. Please, use format: y-mm-ddThh:mm:ssZ", *dateStr)
 		os.Exit(1)
 	}
-	dt := deltat.DeltaT(jd)
-	jde := jd + dt/86400 // Dynamic time.
-
-	dpsi, deps := nutequ.Nutation(jde)
-	eps := nutequ.TrueObliquity(jde, deps)
-	opts := sidereal.SiderealOptions{Lng: *lng, Dpsi: dpsi, Eps: eps}
+	// nutation and obliquity are calculated with default Delta-T and nutation models
+	opts := sidereal.SiderealOptions{Lng: *lng, Kind: sidereal.KindAutoApparent}
 	lst := sidereal.JulianToSidereal(jd, opts)
-	hrs, min, sec := mathutils.Hms(lst)
+	_, hrs, min, sec := mathutils.RoundSexagesimal(lst, 1)
 
 	fmt.Printf("%02d:%02d:%04.1f\n", hrs, min, sec)
 }
@@ -26,6 +26,18 @@ const J2000 = 2451545.0
 // Julian day for 1900 Jan. 0.5
 const J1900 = 2415020.0
 
+// Days per Julian year
+const DAYS_PER_YEAR = 365.25
+
+// Julian Date of Modified Julian Date zero, 1858 Nov. 17, 0h
+const MJD_ZERO = 2400000.5
+
+// Days per tropical year, used for Besselian epochs
+const DAYS_PER_BESSELIAN_YEAR = 365.242198781
+
+// Julian Date of Besselian epoch B1900.0
+const B1900 = 2415020.31352
+
 // Dates before Oct 10, 1582 are considered non-gregorian
 func isGregorian(date CivilDate) bool {
 	if date.Year > 1582 {
@@ -120,24 +132,42 @@ func JulianDateZero(year int) float64 {
 	return math.Trunc(365.25*y) - a + math.Trunc(a/4) + 1721424.5
 }
 
+// Given Julian [epoch], e.g. 1991.25, calculate Julian Date (TT).
+func JulianEpochToJulian(epoch float64) float64 {
+	return J2000 + (epoch-2000)*DAYS_PER_YEAR
+}
+
+// Given Julian Date (TT), calculate Julian epoch.
+func JulianToJulianEpoch(jd float64) float64 {
+	return 2000 + (jd-J2000)/DAYS_PER_YEAR
+}
+
+// Given Besselian [epoch], e.g. 1950.0, calculate Julian Date (TT).
+func BesselianEpochToJulian(epoch float64) float64 {
+	return B1900 + (epoch-1900)*DAYS_PER_BESSELIAN_YEAR
+}
+
+// Given Julian Date (TT), calculate Besselian epoch.
+func JulianToBesselianEpoch(jd float64) float64 {
+	return 1900 + (jd-B1900)/DAYS_PER_BESSELIAN_YEAR
+}
+
 // Converts fractional part of a Julian Date to UTC as decimal hours.
 func ExtractUTC(jd float64) float64 {
 	return (jd - JulianMidnight(jd)) * 24
 }
 
-// Given an date string, calculate Julian Date.
+// Given an date string, calculate Julian Date: UTC for calendar dates, TT
+// for Julian and Besselian epochs.
 //
-// The date must be in RFC3339 format without time zone offset, i.e.:
+// Besides RFC3339, any format accepted by ParseDate will do; time zone
+// offset and fractional seconds are taken into account. Like CivilToJulian,
+// dates before October 15, 1582 are Julian calendar dates, i.e.:
 //
 //	jd, _ := DateStringToJulian("2006-01-02T15:04:05Z")
+//	jd, _ = DateStringToJulian("2006-01-02T18:04:05.5+03:00")
 func DateStringToJulian(date string) (float64, error) {
-	t, err := time.Parse(time.RFC3339, date)
-	if err != nil {
-		return 0, err
-	}
-	ut := float64(t.Hour()) + float64(t.Minute())/60
-	jd := CivilToJulian(CivilDate{Year: t.Year(), Month: int(t.Month()), Day: float64(t.Day()) + ut/24})
-	return jd, nil
+	return ParseDate(date)
 }
 
 // Given Julian Date return RFC-3339 formatted date string.
@@ -108,6 +108,18 @@ func TestJulianDateZero(t *testing.T) {
 	}
 }
 
+func TestJulianEpoch(t *testing.T) {
+	// Hipparcos catalogue epoch
+	exp := 2448349.0625
+	got := JulianEpochToJulian(1991.25)
+	if !mathutils.AlmostEqual(got, exp, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+	if !mathutils.AlmostEqual(JulianToJulianEpoch(got), 1991.25, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", 1991.25, JulianToJulianEpoch(got))
+	}
+}
+
 func TestExtractUTCBeforeNoon(t *testing.T) {
 	exp := 11.76
 	got := ExtractUTC(2438792.99)
@@ -132,6 +144,17 @@ func TestDateStringToJulian(t *testing.T) {
 	}
 }
 
+func TestDateStringToJulianWithOffset(t *testing.T) {
+	exp := 2438792.990630787
+	got, err := DateStringToJulian("1965-02-01T14:46:30.5+03:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mathutils.AlmostEqual(got, exp, 1e-8) {
+		t.Errorf("Expected: %.08f, got: %.08f", exp, got)
+	}
+}
+
 func TestJulianToDateString(t *testing.T) {
 	exp := "1965-02-01T11:46:00Z"
 	got := JulianToDateString(2438792.990277778)
@@ -0,0 +1,471 @@
+package julian
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Returned by ParseDate when a string can not be parsed.
+type ParseError struct {
+	// the whole input string
+	Input string
+	// byte offset of the place where parsing failed
+	Pos int
+	// what was expected or what is wrong
+	Msg string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("julian: cannot parse %q at position %d: %s", e.Input, e.Pos, e.Msg)
+}
+
+var _MONTHS = [12]string{
+	"january", "february", "march", "april", "may", "june",
+	"july", "august", "september", "october", "november", "december",
+}
+
+// Given a date string, calculate Julian Date. The following forms are
+// accepted, surrounding spaces and letter case do not matter:
+//
+//	2023-04-13T06:00:00.5+03:00   ISO 8601, also "2023-04-13 06:00", "2023-04-13"
+//	-0043-03-15, 0044-03-15 BC    astronomical and BC/BCE years, "AD" and "CE" are allowed too
+//	2023-103T06:00Z               ordinal date: year and day of the year
+//	1900 Jan 0.5                  month name and fractional day, which may be zero
+//	2023.5                        decimal year
+//	JD 2460047.5, MJD 60047       Julian and Modified Julian Dates
+//	B1950.0, J2000                Besselian and Julian epochs
+//
+// Calendar dates without time zone are UTC; dates before October 15, 1582
+// are Julian calendar dates, like in CivilToJulian. Julian and Modified
+// Julian Dates are returned as they are, in their own time scale. Julian and
+// Besselian epochs are defined in TT, so they give TT Julian Dates, not UTC.
+//
+// On failure the error is *ParseError.
+func ParseDate(s string) (float64, error) {
+	p := &dateParser{s: s}
+	p.skipSpaces()
+	if p.eof() {
+		return 0, p.fail("empty date")
+	}
+
+	var jd float64
+	var err error
+	switch w := strings.ToUpper(p.peekWord()); {
+	case w == "JD" || w == "MJD":
+		p.pos += len(w)
+		p.skipSpaces()
+		if jd, err = p.number(); err == nil && w == "MJD" {
+			jd += MJD_ZERO
+		}
+	case w == "J" || w == "B":
+		p.pos++
+		var epoch float64
+		if epoch, err = p.number(); err == nil {
+			if w == "J" {
+				jd = JulianEpochToJulian(epoch)
+			} else {
+				jd = BesselianEpochToJulian(epoch)
+			}
+		}
+	case w == "":
+		jd, err = p.date()
+	default:
+		err = p.fail(fmt.Sprintf("unknown prefix %q", w))
+	}
+	if err != nil {
+		return 0, err
+	}
+	p.skipSpaces()
+	if !p.eof() {
+		return 0, p.fail("unexpected trailing characters")
+	}
+	return jd, nil
+}
+
+type dateParser struct {
+	s   string
+	pos int
+}
+
+func (p *dateParser) fail(msg string) *ParseError {
+	return &ParseError{Input: p.s, Pos: p.pos, Msg: msg}
+}
+
+func (p *dateParser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+// Current character, 0 at the end of the string.
+func (p *dateParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *dateParser) skipSpaces() {
+	for !p.eof() && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// Letters starting at the current position, without consuming them.
+func (p *dateParser) peekWord() string {
+	end := p.pos
+	for end < len(p.s) && isLetter(p.s[end]) {
+		end++
+	}
+	return p.s[p.pos:end]
+}
+
+// Consumes and returns a run of digits.
+func (p *dateParser) digits() string {
+	start := p.pos
+	for !p.eof() && isDigit(p.s[p.pos]) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// Consumes exactly [n] digits and returns their value.
+func (p *dateParser) fixed(n int, what string) (int, error) {
+	end := p.pos + n
+	if end > len(p.s) {
+		end = len(p.s)
+	}
+	d := p.s[p.pos:end]
+	for i := 0; i < len(d); i++ {
+		if !isDigit(d[i]) {
+			d = d[:i]
+			break
+		}
+	}
+	if len(d) != n {
+		return 0, p.fail(fmt.Sprintf("expected %d-digit %s", n, what))
+	}
+	p.pos = end
+	v, _ := strconv.Atoi(d)
+	return v, nil
+}
+
+// Consumes an optionally signed decimal number, like -12.5.
+func (p *dateParser) number() (float64, error) {
+	start := p.pos
+	if c := p.peek(); c == '+' || c == '-' {
+		p.pos++
+	}
+	n := len(p.digits())
+	if p.peek() == '.' {
+		p.pos++
+		n += len(p.digits())
+	}
+	if n == 0 {
+		p.pos = start
+		return 0, p.fail("expected a number")
+	}
+	v, _ := strconv.ParseFloat(p.s[start:p.pos], 64)
+	return v, nil
+}
+
+// Consumes a decimal fraction, like .25 or ,25 (ISO 8601 allows comma),
+// if any.
+func (p *dateParser) fraction() float64 {
+	if c := p.peek(); c != '.' && c != ',' {
+		return 0
+	}
+	start := p.pos
+	p.pos++
+	d := p.digits()
+	if d == "" {
+		p.pos = start
+		return 0
+	}
+	v, _ := strconv.ParseFloat("0."+d, 64)
+	return v
+}
+
+// Leap year rule of the calendar in effect in February of [year]: Julian
+// before the reform of 1582, Gregorian after it, like in CivilToJulian.
+func isLeapYearOf(year int) bool {
+	if isGregorian(CivilDate{Year: year, Month: 2, Day: 1}) {
+		return IsLeapYear(year)
+	}
+	return year%4 == 0
+}
+
+// Number of days in [year], 355 in 1582, when ten days were dropped.
+func daysInYear(year int) int {
+	jd0 := CivilToJulian(CivilDate{Year: year, Month: 1, Day: 1})
+	jd1 := CivilToJulian(CivilDate{Year: year + 1, Month: 1, Day: 1})
+	return int(math.Round(jd1 - jd0))
+}
+
+func daysInMonth(year, month int) int {
+	switch month {
+	case 2:
+		if isLeapYearOf(year) {
+			return 29
+		}
+		return 28
+	case 4, 6, 9, 11:
+		return 30
+	}
+	return 31
+}
+
+// Parses a calendar date, an ordinal date or a decimal year.
+func (p *dateParser) date() (float64, error) {
+	signed := false
+	sign := 1
+	if c := p.peek(); c == '+' || c == '-' {
+		signed = true
+		if c == '-' {
+			sign = -1
+		}
+		p.pos++
+	}
+	yearPos := p.pos
+	d := p.digits()
+	if d == "" {
+		return 0, p.fail("expected year")
+	}
+	year, err := strconv.Atoi(d)
+	if err != nil {
+		return 0, &ParseError{Input: p.s, Pos: yearPos, Msg: "year out of range"}
+	}
+	year *= sign
+
+	var month, doy int
+	var day, hours, offset float64
+	named := false // month name
+	monthPos := p.pos
+	dayPos := p.pos
+	switch {
+	case p.peek() == '.' || p.eof():
+		// decimal year
+		y := float64(year) + float64(sign)*p.fraction()
+		if !p.eof() && p.peek() != ' ' && p.peek() != '\t' {
+			return 0, p.fail("expected digits after decimal point")
+		}
+		return decimalYearToJulian(y), nil
+	case p.peek() == '-':
+		// ISO 8601 calendar or ordinal date
+		p.pos++
+		monthPos = p.pos
+		dayPos = p.pos
+		switch len(p.peekDigits()) {
+		case 3:
+			doy, _ = p.fixed(3, "day of the year")
+		case 2:
+			month, _ = p.fixed(2, "month")
+			if p.peek() != '-' {
+				return 0, p.fail("expected '-' before day")
+			}
+			p.pos++
+			dayPos = p.pos
+			var dd int
+			if dd, err = p.fixed(2, "day"); err != nil {
+				return 0, err
+			}
+			day = float64(dd)
+		default:
+			return 0, p.fail("expected 2-digit month or 3-digit day of the year")
+		}
+		if c := p.peek(); c == 'T' || c == 't' || (c == ' ' && p.pos+1 < len(p.s) && isDigit(p.s[p.pos+1])) {
+			p.pos++
+			if hours, err = p.time(); err != nil {
+				return 0, err
+			}
+			if offset, err = p.zone(); err != nil {
+				return 0, err
+			}
+		}
+	case p.peek() == ' ' || p.peek() == '\t':
+		// 1900 Jan 0.5
+		named = true
+		p.skipSpaces()
+		monthPos = p.pos
+		if month, err = p.monthName(); err != nil {
+			return 0, err
+		}
+		p.skipSpaces()
+		dayPos = p.pos
+		if day, err = p.number(); err != nil {
+			return 0, p.fail("expected day")
+		}
+		if !strings.Contains(p.s[dayPos:p.pos], ".") && p.pos+1 < len(p.s) && p.peek() == ' ' && isDigit(p.s[p.pos+1]) {
+			p.pos++
+			if hours, err = p.time(); err != nil {
+				return 0, err
+			}
+			if offset, err = p.zone(); err != nil {
+				return 0, err
+			}
+		}
+	default:
+		return 0, p.fail("expected '-', '.' or month name after year")
+	}
+
+	// era
+	p.skipSpaces()
+	switch w := strings.ToUpper(p.peekWord()); w {
+	case "":
+	case "BC", "BCE", "AD", "CE":
+		if signed {
+			return 0, p.fail("signed year can not have an era")
+		}
+		if year == 0 {
+			return 0, &ParseError{Input: p.s, Pos: yearPos, Msg: "there is no year 0 in eras"}
+		}
+		if w == "BC" || w == "BCE" {
+			year = 1 - year
+		}
+		p.pos += len(w)
+	default:
+		return 0, p.fail(fmt.Sprintf("unknown era %q", w))
+	}
+
+	if doy != 0 || month == 0 {
+		if doy < 1 || doy > daysInYear(year) {
+			return 0, &ParseError{Input: p.s, Pos: dayPos, Msg: "day of the year out of range"}
+		}
+		jd := CivilToJulian(CivilDate{Year: year, Month: 1, Day: 1}) + float64(doy-1)
+		return jd + (hours-offset)/24, nil
+	}
+	if month < 1 || month > 12 {
+		return 0, &ParseError{Input: p.s, Pos: monthPos, Msg: "month out of range"}
+	}
+	// zero day, like 1900 Jan 0.5, is allowed only with month name
+	minDay := 1.0
+	if named {
+		minDay = 0
+	}
+	if day < minDay || math.Floor(day) > float64(daysInMonth(year, month)) {
+		return 0, &ParseError{Input: p.s, Pos: dayPos, Msg: "day out of range"}
+	}
+	if year == 1582 && month == 10 && day >= 5 && day < 15 {
+		return 0, &ParseError{Input: p.s, Pos: dayPos, Msg: "day skipped by the Gregorian reform"}
+	}
+	jd := CivilToJulian(CivilDate{Year: year, Month: month, Day: day})
+	return jd + (hours-offset)/24, nil
+}
+
+// Digits starting at the current position, without consuming them.
+func (p *dateParser) peekDigits() string {
+	start := p.pos
+	d := p.digits()
+	p.pos = start
+	return d
+}
+
+// Parses English month name or its abbreviation of at least 3 letters.
+func (p *dateParser) monthName() (int, error) {
+	w := strings.ToLower(p.peekWord())
+	if len(w) >= 3 {
+		for i, m := range _MONTHS {
+			if strings.HasPrefix(m, w) || (w == "sept" && i == 8) {
+				p.pos += len(w)
+				if p.peek() == '.' {
+					p.pos++ // "Jan."
+				}
+				return i + 1, nil
+			}
+		}
+	}
+	return 0, p.fail("expected month name")
+}
+
+// Parses time of the day, hh:mm[:ss[.sss]], and returns decimal hours.
+func (p *dateParser) time() (float64, error) {
+	start := p.pos
+	h, err := p.fixed(2, "hours")
+	if err != nil {
+		return 0, err
+	}
+	if p.peek() != ':' {
+		return 0, p.fail("expected ':' after hours")
+	}
+	p.pos++
+	minPos := p.pos
+	m, err := p.fixed(2, "minutes")
+	if err != nil {
+		return 0, err
+	}
+	var sec float64
+	secPos := p.pos
+	if p.peek() == ':' {
+		p.pos++
+		secPos = p.pos
+		var s int
+		if s, err = p.fixed(2, "seconds"); err != nil {
+			return 0, err
+		}
+		sec = float64(s) + p.fraction()
+	}
+	switch {
+	case h > 23:
+		return 0, &ParseError{Input: p.s, Pos: start, Msg: "hours out of range"}
+	case m > 59:
+		return 0, &ParseError{Input: p.s, Pos: minPos, Msg: "minutes out of range"}
+	case sec >= 61:
+		// 60 is allowed for leap seconds
+		return 0, &ParseError{Input: p.s, Pos: secPos, Msg: "seconds out of range"}
+	}
+	return float64(h) + float64(m)/60 + sec/3600, nil
+}
+
+// Parses optional time zone, Z, ±hh, ±hh:mm or ±hhmm, and returns offset
+// from UTC in decimal hours.
+func (p *dateParser) zone() (float64, error) {
+	c := p.peek()
+	switch c {
+	case 'Z', 'z':
+		p.pos++
+		return 0, nil
+	case '+', '-':
+	default:
+		return 0, nil
+	}
+	start := p.pos
+	p.pos++
+	h, err := p.fixed(2, "zone hours")
+	if err != nil {
+		return 0, err
+	}
+	var m int
+	if p.peek() == ':' {
+		p.pos++
+		if m, err = p.fixed(2, "zone minutes"); err != nil {
+			return 0, err
+		}
+	} else if len(p.peekDigits()) == 2 {
+		m, _ = p.fixed(2, "zone minutes")
+	}
+	if h > 23 || m > 59 {
+		return 0, &ParseError{Input: p.s, Pos: start, Msg: "zone offset out of range"}
+	}
+	offset := float64(h) + float64(m)/60
+	if c == '-' {
+		offset = -offset
+	}
+	return offset, nil
+}
+
+// Julian Date of decimal year [y], e.g. 2023.5, as fraction of the calendar
+// year length.
+func decimalYearToJulian(y float64) float64 {
+	year := math.Floor(y)
+	jd0 := CivilToJulian(CivilDate{Year: int(year), Month: 1, Day: 1})
+	jd1 := CivilToJulian(CivilDate{Year: int(year) + 1, Month: 1, Day: 1})
+	return jd0 + (y-year)*(jd1-jd0)
+}
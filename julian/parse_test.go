@@ -0,0 +1,104 @@
+package julian
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+func TestParseDate(t *testing.T) {
+	cases := []struct {
+		input string
+		jd    float64
+	}{
+		{input: "2023-04-13T06:00:00Z", jd: 2460047.75},
+		{input: "2023-04-13T09:00:00+03:00", jd: 2460047.75},
+		{input: "2023-04-13t02:00:00-0400", jd: 2460047.75},
+		{input: "2023-04-13 06:00", jd: 2460047.75},
+		{input: "  2023-04-13T05:59:59,5+00 ", jd: 2460047.75 - 0.5/86400},
+		{input: "2023-04-13", jd: 2460047.5},
+		{input: "2023-103", jd: 2460047.5},
+		{input: "2023-103T06:00Z", jd: 2460047.75},
+		{input: "1900 Jan 0.5", jd: 2415020.0},
+		{input: "2000 january 1.5", jd: J2000},
+		{input: "2023 Apr. 13 06:00", jd: 2460047.75},
+		{input: "-1000-07-12T12:00", jd: 1356001.0},
+		{input: "1001-07-12T12:00 BC", jd: 1356001.0},
+		{input: "1001 Jul 12.5 BCE", jd: 1356001.0},
+		{input: "837 Apr 10.3 AD", jd: 2026871.8},
+		{input: "1582-10-04", jd: 2299159.5},
+		{input: "1582-10-15", jd: 2299160.5},
+		{input: "1500-02-29", jd: 2268991.5},
+		{input: "1582-355", jd: 2299237.5},
+		{input: "2023.5", jd: 2459945.5 + 0.5*365},
+		{input: "2000", jd: 2451544.5},
+		{input: "JD 2460047.5", jd: 2460047.5},
+		{input: "jd2460047.5", jd: 2460047.5},
+		{input: "MJD 60047", jd: 2460047.5},
+		{input: "J2000", jd: J2000},
+		{input: "J1991.25", jd: 2448349.0625},
+		{input: "B1950.0", jd: 2433282.4235},
+		{input: "B1900", jd: 2415020.3135},
+	}
+	for _, c := range cases {
+		got, err := ParseDate(c.input)
+		if err != nil {
+			t.Errorf("%q: %v", c.input, err)
+			continue
+		}
+		if !mathutils.AlmostEqual(got, c.jd, 1e-4) {
+			t.Errorf("%q: Expected: %f, got: %f", c.input, c.jd, got)
+		}
+	}
+}
+
+func TestParseDateErrors(t *testing.T) {
+	cases := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{input: "", pos: 0, msg: "empty date"},
+		{input: "2023-13-01", pos: 5, msg: "month out of range"},
+		{input: "2023-02-29", pos: 8, msg: "day out of range"},
+		{input: "2023-01-00", pos: 8, msg: "day out of range"},
+		{input: "2023-366", pos: 5, msg: "day of the year out of range"},
+		{input: "1582-10-10", pos: 8, msg: "Gregorian reform"},
+		{input: "1700-02-29", pos: 8, msg: "day out of range"},
+		{input: "1582-356", pos: 5, msg: "day of the year out of range"},
+		{input: "2023-04-13T25:00", pos: 11, msg: "hours out of range"},
+		{input: "2023-04-13T06", pos: 13, msg: "expected ':'"},
+		{input: "2023-4-13", pos: 5, msg: "expected 2-digit month"},
+		{input: "2023 Foo 1", pos: 5, msg: "expected month name"},
+		{input: "2023-04-13 junk", pos: 11, msg: "unknown era"},
+		{input: "-44-03-15 BC", pos: 10, msg: "signed year"},
+		{input: "0 Mar 15 BC", pos: 0, msg: "no year 0"},
+		{input: "XJD 5", pos: 0, msg: "unknown prefix"},
+		{input: "JD", pos: 2, msg: "expected a number"},
+		{input: "2023.5x", pos: 6, msg: "expected digits"},
+		{input: "2023-04-13Z", pos: 10, msg: "unknown era"},
+		{input: "2023-04-13T06:00 x", pos: 17, msg: "unknown era"},
+		{input: "J2000 TT", pos: 6, msg: "unexpected trailing"},
+	}
+	for _, c := range cases {
+		_, err := ParseDate(c.input)
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%q: expected ParseError, got: %v", c.input, err)
+			continue
+		}
+		if perr.Pos != c.pos || !strings.Contains(perr.Msg, c.msg) {
+			t.Errorf("%q: Expected: %d %q, got: %d %q", c.input, c.pos, c.msg, perr.Pos, perr.Msg)
+		}
+	}
+}
+
+func TestBesselianEpoch(t *testing.T) {
+	exp := 1950.0
+	got := JulianToBesselianEpoch(BesselianEpochToJulian(exp))
+	if !mathutils.AlmostEqual(got, exp, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+}
@@ -0,0 +1,134 @@
+package julian
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Julian Date of the Unix epoch, 1970 Jan 1, 0h UTC.
+const UNIX_EPOCH = 2440587.5
+
+// Returned for a local time which falls into a gap, when clocks are put
+// forward, e.g. at the start of Daylight Saving Time.
+var ErrNonexistentTime = errors.New("julian: local time does not exist in the time zone")
+
+// Returned for BC dates and dates beyond the range of Go time.
+var ErrYearRange = errors.New("julian: year out of range of Go time")
+
+// Last year of Go time, which Unix seconds can represent.
+const _MAX_YEAR = 292277026595
+
+// Julian Dates of 0001 Jan 1 and of the year after _MAX_YEAR, 0h UTC,
+// proleptic Gregorian.
+const (
+	_MIN_TIME_JD = 1721425.5
+	_MAX_TIME_JD = 106751993607549.5
+)
+
+func unixToJulian(sec int64, ns int) float64 {
+	return UNIX_EPOCH + (float64(sec)+float64(ns)*1e-9)/SEC_PER_DAY
+}
+
+// Given time [t], calculate Julian Date, UTC. The time zone of t is taken
+// into account.
+//
+// Go time uses proleptic Gregorian calendar, while CivilToJulian switches to
+// Julian calendar before October 15, 1582, so that civil dates of the two
+// differ before that date. Julian Date of the instant is the same.
+func TimeToJulian(t time.Time) (float64, error) {
+	if y := t.UTC().Year(); y < 1 || y > _MAX_YEAR {
+		return 0, ErrYearRange
+	}
+	return unixToJulian(t.Unix(), t.Nanosecond()), nil
+}
+
+// Given [jd], UTC Julian Date, return time in [loc] time zone, UTC if nil,
+// rounded to microseconds, which is about the precision of a Julian Date.
+func JulianToTime(jd float64, loc *time.Location) (time.Time, error) {
+	if math.IsNaN(jd) || jd < _MIN_TIME_JD || jd >= _MAX_TIME_JD {
+		return time.Time{}, ErrYearRange
+	}
+	days := jd - UNIX_EPOCH
+	sec := math.Floor(days * SEC_PER_DAY)
+	us := math.Round((days*SEC_PER_DAY - sec) * 1e6)
+	t := time.Unix(int64(sec), int64(us)*1000).UTC()
+	if loc != nil {
+		t = t.In(loc)
+	}
+	return t, nil
+}
+
+// Given [jd], UTC Julian Date and [zone], IANA time zone name, like
+// "Europe/Moscow", return local date and time. The zone is loaded from
+// the system time zone database.
+func JulianToZone(jd float64, zone string) (time.Time, error) {
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return JulianToTime(jd, loc)
+}
+
+// Given local civil [date], with time as fractional part of the day, and
+// [loc] time zone, calculate all UTC Julian Dates it may correspond to, in
+// ascending order: none in a gap, when clocks are put forward, two in an
+// overlap, when clocks are put back, one otherwise.
+//
+// Like CivilToJulian, dates before October 15, 1582 are Julian calendar
+// dates; they are shifted to the proleptic Gregorian calendar of Go time.
+func LocalToJulianAll(date CivilDate, loc *time.Location) ([]float64, error) {
+	if date.Year < 1 || date.Year > _MAX_YEAR {
+		return nil, ErrYearRange
+	}
+	day := math.Floor(date.Day)
+	ns := int64(math.Round((date.Day - day) * SEC_PER_DAY * 1e9))
+	d := int(day)
+	if !isGregorian(date) {
+		greg := time.Date(date.Year, time.Month(date.Month), d, 0, 0, 0, 0, time.UTC)
+		jd := CivilToJulian(CivilDate{Year: date.Year, Month: date.Month, Day: day})
+		d += int(math.Round(jd - unixToJulian(greg.Unix(), 0)))
+	}
+	sec := ns / 1e9
+	clock := func(l *time.Location) time.Time {
+		return time.Date(date.Year, time.Month(date.Month), d, int(sec/3600), int(sec/60%60), int(sec%60), int(ns%1e9), l)
+	}
+	wall := clock(loc)
+	// same wall clock in UTC, shifted by the zone offset below
+	utc := clock(time.UTC)
+
+	offsets := make(map[int]bool)
+	for _, h := range []int{-36, 0, 36} {
+		_, off := wall.Add(time.Duration(h) * time.Hour).Zone()
+		offsets[off] = true
+	}
+	var res []float64
+	for off := range offsets {
+		t := utc.Add(-time.Duration(off) * time.Second)
+		if _, o := t.In(loc).Zone(); o != off {
+			continue
+		}
+		res = append(res, unixToJulian(t.Unix(), t.Nanosecond()))
+	}
+	sort.Float64s(res)
+	return res, nil
+}
+
+// Given local civil [date] and [loc] time zone, calculate UTC Julian
+// Date. In an overlap, when clocks are put back, the earlier moment is
+// returned; a local time in a gap gives ErrNonexistentTime.
+//
+//	msk, _ := time.LoadLocation("Europe/Moscow")
+//	jd, err := LocalToJulian(CivilDate{Year: 2023, Month: 4, Day: 13.5}, msk)
+func LocalToJulian(date CivilDate, loc *time.Location) (float64, error) {
+	res, err := LocalToJulianAll(date, loc)
+	if err != nil {
+		return 0, err
+	}
+	if len(res) == 0 {
+		return 0, fmt.Errorf("%w: %d-%02d-%09.6f %s", ErrNonexistentTime, date.Year, date.Month, date.Day, loc)
+	}
+	return res[0], nil
+}
@@ -0,0 +1,194 @@
+package julian
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// One millisecond in days
+const _MS = 1e-3 / SEC_PER_DAY
+
+func loadZone(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s is not available: %v", name, err)
+	}
+	return loc
+}
+
+func TestTimeToJulian(t *testing.T) {
+	cases := []struct {
+		t   time.Time
+		exp float64
+	}{
+		{t: time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), exp: UNIX_EPOCH},
+		{t: time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC), exp: J2000},
+		{t: time.Date(2023, 4, 13, 9, 0, 0, 0, time.FixedZone("MSK", 3*3600)), exp: 2460047.75},
+		{t: time.Date(1965, 2, 1, 11, 46, 30, 500e6, time.UTC), exp: 2438792.990630787},
+	}
+	for _, test := range cases {
+		got, err := TimeToJulian(test.t)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !mathutils.AlmostEqual(got, test.exp, _MS) {
+			t.Errorf("Expected: %f, got: %f", test.exp, got)
+		}
+	}
+}
+
+func TestTimeToJulianRange(t *testing.T) {
+	for _, tm := range []time.Time{
+		time.Date(0, 12, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(-500, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(_MAX_YEAR+1, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		if _, err := TimeToJulian(tm); !errors.Is(err, ErrYearRange) {
+			t.Errorf("Expected ErrYearRange for %v, got: %v", tm, err)
+		}
+	}
+}
+
+func TestJulianToTime(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*3600)
+	got, err := JulianToTime(2460047.75, msk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := time.Date(2023, 4, 13, 9, 0, 0, 0, msk)
+	if !got.Equal(exp) || got.Location() != msk {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+	if got.Hour() != 9 {
+		t.Errorf("Expected: %d, got: %d", 9, got.Hour())
+	}
+	for _, jd := range []float64{1721425.4, _MAX_TIME_JD, -1e9} {
+		if _, err := JulianToTime(jd, nil); !errors.Is(err, ErrYearRange) {
+			t.Errorf("Expected ErrYearRange for %f, got: %v", jd, err)
+		}
+	}
+}
+
+func TestTimeAfter9999(t *testing.T) {
+	exp := time.Date(12000, 1, 1, 0, 0, 0, 0, time.UTC)
+	jd, err := TimeToJulian(exp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := JulianToTime(jd, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(exp) {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+}
+
+func TestJulianToTimeRoundTrip(t *testing.T) {
+	exp := time.Date(2024, 2, 29, 23, 59, 59, 123456000, time.UTC)
+	jd, _ := TimeToJulian(exp)
+	got, _ := JulianToTime(jd, nil)
+	if d := got.Sub(exp); d < -50*time.Microsecond || d > 50*time.Microsecond {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+}
+
+func TestJulianToZone(t *testing.T) {
+	loadZone(t, "America/New_York")
+	got, err := JulianToZone(2460047.75, "America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// EDT, UTC-4
+	if got.Day() != 13 || got.Hour() != 2 {
+		t.Errorf("Expected: 2023-04-13 02:00, got: %v", got)
+	}
+	if _, err := JulianToZone(2460047.75, "Nowhere/Nothing"); err == nil {
+		t.Errorf("Expected error for unknown zone")
+	}
+}
+
+func TestLocalToJulian(t *testing.T) {
+	ny := loadZone(t, "America/New_York")
+	// 2023 Mar 12: 2:00 EST -> 3:00 EDT; 2023 Nov 5: 2:00 EDT -> 1:00 EST
+	cases := []struct {
+		date CivilDate
+		exp  []float64
+	}{
+		// 12:00 EDT = 16:00 UTC
+		{date: CivilDate{Year: 2023, Month: 7, Day: 1.5}, exp: []float64{2460127.1666667}},
+		// 2:30 does not exist
+		{date: CivilDate{Year: 2023, Month: 3, Day: 12 + 2.5/24}, exp: nil},
+		// 1:30 EDT = 5:30 UTC and 1:30 EST = 6:30 UTC
+		{date: CivilDate{Year: 2023, Month: 11, Day: 5 + 1.5/24}, exp: []float64{2460253.7291667, 2460253.7708333}},
+	}
+	for _, test := range cases {
+		got, err := LocalToJulianAll(test.date, ny)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(test.exp) {
+			t.Fatalf("Expected: %v, got: %v", test.exp, got)
+		}
+		for i := range got {
+			if !mathutils.AlmostEqual(got[i], test.exp[i], 1e-6) {
+				t.Errorf("Expected: %f, got: %f", test.exp[i], got[i])
+			}
+		}
+	}
+
+	if _, err := LocalToJulian(CivilDate{Year: 2023, Month: 3, Day: 12 + 2.5/24}, ny); !errors.Is(err, ErrNonexistentTime) {
+		t.Errorf("Expected ErrNonexistentTime, got: %v", err)
+	}
+	got, _ := LocalToJulian(CivilDate{Year: 2023, Month: 11, Day: 5 + 1.5/24}, ny)
+	if !mathutils.AlmostEqual(got, 2460253.7291667, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 2460253.7291667, got)
+	}
+	if _, err := LocalToJulian(CivilDate{Year: -44, Month: 3, Day: 15}, ny); !errors.Is(err, ErrYearRange) {
+		t.Errorf("Expected ErrYearRange, got: %v", err)
+	}
+}
+
+func TestLocalToJulianBeforeGregorian(t *testing.T) {
+	// Julian calendar date, as in CivilToJulian
+	date := CivilDate{Year: 1000, Month: 3, Day: 15.5}
+	exp := CivilToJulian(date) // 2086382.0
+	got, err := LocalToJulian(date, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mathutils.AlmostEqual(got, exp, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+	got, err = LocalToJulian(date, time.FixedZone("UTC+3", 3*3600))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mathutils.AlmostEqual(got, exp-0.125, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", exp-0.125, got)
+	}
+	// the moment precedes year 1 of Go time
+	date = CivilDate{Year: 1, Month: 1, Day: 1}
+	exp = CivilToJulian(date) - 0.125
+	got, err = LocalToJulian(date, time.FixedZone("UTC+3", 3*3600))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mathutils.AlmostEqual(got, exp, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+}
+
+func TestLocalToJulianAfter9999(t *testing.T) {
+	date := CivilDate{Year: 12000, Month: 7, Day: 1.25}
+	got, err := LocalToJulian(date, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := CivilToJulian(date); !mathutils.AlmostEqual(got, exp, _MS) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+}
@@ -0,0 +1,254 @@
+package mathutils
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Angle in radians.
+//
+//	a := AngleFromDMS(true, 23, 26, 21.4)
+//	a.FormatDMS(1) // -23°26'21.4"
+type Angle float64
+
+// Angle from arc-degrees.
+func AngleFromDegrees(deg float64) Angle {
+	return Angle(Radians(deg))
+}
+
+// Angle from hours, 1h = 15°.
+func AngleFromHours(hours float64) Angle {
+	return Angle(Radians(hours * 15))
+}
+
+// Angle from arc-degrees, minutes and seconds. Components are expected
+// to be non-negative, sign of the angle is set by [neg] flag, so that
+// -0°30' can be represented.
+func AngleFromDMS(neg bool, deg, min int, sec float64) Angle {
+	x := float64(deg) + float64(min)/60 + sec/3600
+	if neg {
+		x = -x
+	}
+	return AngleFromDegrees(x)
+}
+
+// Angle from hours, minutes and seconds of time. See AngleFromDMS.
+func AngleFromHMS(neg bool, hours, min int, sec float64) Angle {
+	x := float64(hours) + float64(min)/60 + sec/3600
+	if neg {
+		x = -x
+	}
+	return AngleFromHours(x)
+}
+
+// Radians.
+func (a Angle) Radians() float64 {
+	return float64(a)
+}
+
+// Arc-degrees.
+func (a Angle) Degrees() float64 {
+	return Degrees(float64(a))
+}
+
+// Hours, 15° = 1h.
+func (a Angle) Hours() float64 {
+	return a.Degrees() / 15
+}
+
+// Angle reduced to range 0 >= x < 2 * pi.
+func (a Angle) Reduce() Angle {
+	return Angle(ReduceRad(float64(a)))
+}
+
+// Arc-degrees, minutes and seconds, sign is returned separately.
+func (a Angle) DMS() (neg bool, deg, min int, sec float64) {
+	return split(a.Degrees())
+}
+
+// Hours, minutes and seconds of time, sign is returned separately.
+func (a Angle) HMS() (neg bool, hours, min int, sec float64) {
+	return split(a.Hours())
+}
+
+func split(x float64) (neg bool, a, b int, c float64) {
+	neg = x < 0
+	i, f := math.Modf(math.Abs(x))
+	j, f := math.Modf(f * 60)
+	return neg, int(i), int(j), f * 60
+}
+
+// Converts decimal value, e.g. hours, to sexagesimal components with seconds
+// rounded to [prec] decimal places. Unlike Hms, rounding carries over to
+// minutes and the integer part, so 59.9999 seconds never turns into "60.0".
+// Sign is returned separately; a value which rounds to zero is not negative.
+//
+//	RoundSexagesimal(-0.999999, 1) = true, 1, 0, 0.0
+//	RoundSexagesimal(-0.00000001, 1) = false, 0, 0, 0.0
+func RoundSexagesimal(x float64, prec int) (neg bool, a, b int, c float64) {
+	if prec < 0 {
+		prec = 0
+	}
+	scale := math.Pow(10, float64(prec))
+	// total number of seconds fractions
+	n := math.Round(math.Abs(x) * 3600 * scale)
+	if n == 0 {
+		return false, 0, 0, 0
+	}
+	unit := 60 * scale
+	a = int(n / (60 * unit))
+	n -= float64(a) * 60 * unit
+	b = int(n / unit)
+	n -= float64(b) * unit
+	return x < 0, a, b, n / scale
+}
+
+func formatSexagesimal(x float64, prec int, units [3]string) string {
+	if prec < 0 {
+		prec = 0
+	}
+	neg, a, b, c := RoundSexagesimal(x, prec)
+	width := 2
+	if prec > 0 {
+		width += prec + 1
+	}
+	sign := ""
+	if neg {
+		sign = "-"
+	}
+	return fmt.Sprintf("%s%d%s%02d%s%0*.*f%s", sign, a, units[0], b, units[1], width, prec, c, units[2])
+}
+
+// Formats the angle as arc-degrees, minutes and seconds with [prec] decimal
+// places of seconds, e.g. -23°26'21.4"
+func (a Angle) FormatDMS(prec int) string {
+	return formatSexagesimal(a.Degrees(), prec, [3]string{"°", "'", "\""})
+}
+
+// Formats the angle as hours, minutes and seconds of time with [prec]
+// decimal places of seconds, e.g. 12h34m56.7s
+func (a Angle) FormatHMS(prec int) string {
+	return formatSexagesimal(a.Hours(), prec, [3]string{"h", "m", "s"})
+}
+
+// Implements fmt.Stringer, arc-degrees, minutes and seconds.
+func (a Angle) String() string {
+	return a.FormatDMS(1)
+}
+
+// Markers of sexagesimal components: level (0 - degrees or hours, 1 -
+// minutes, 2 - seconds) and whether the value is in hours.
+var _markers = []struct {
+	s     string
+	level int
+	hours bool
+}{
+	{"h", 0, true},
+	{"d", 0, false},
+	{"°", 0, false},
+	{"m", 1, false},
+	{"'", 1, false},
+	{"′", 1, false},
+	{"s", 2, false},
+	{"\"", 2, false},
+	{"″", 2, false},
+	{":", -1, false},
+}
+
+// Parses string representation of an angle. Following formats are accepted:
+//
+//	12h34m56.7s
+//	-23°26'21.4"
+//	123d45m
+//	+12:30:00
+//	-0.5
+//
+// Components may be separated with spaces. Only the last component may have
+// a fractional part. Unmarked values, like "+12:30:00", are arc-degrees; use
+// ParseHours when they are hours.
+func ParseAngle(s string) (Angle, error) {
+	return parseAngle(s, false)
+}
+
+// Same as ParseAngle, but unmarked values, like "12:30:00", are hours.
+func ParseHours(s string) (Angle, error) {
+	return parseAngle(s, true)
+}
+
+func parseAngle(s string, hours bool) (Angle, error) {
+	fail := func(reason string) (Angle, error) {
+		return 0, fmt.Errorf("invalid angle %q: %s", s, reason)
+	}
+	rest := strings.TrimSpace(s)
+	neg := false
+	for _, p := range []string{"+", "-", "−"} {
+		if strings.HasPrefix(rest, p) {
+			neg = p != "+"
+			rest = strings.TrimSpace(rest[len(p):])
+			break
+		}
+	}
+	if rest == "" {
+		return fail("empty value")
+	}
+
+	var parts [3]float64
+	level := 0
+	fractional := false
+	for rest != "" {
+		if level > 2 {
+			return fail("too many components")
+		}
+		if fractional {
+			return fail("fractional part allowed only in the last component")
+		}
+		i := strings.IndexFunc(rest, func(r rune) bool {
+			return !(r >= '0' && r <= '9' || r == '.')
+		})
+		if i < 0 {
+			i = len(rest)
+		}
+		if i == 0 {
+			return fail("number expected")
+		}
+		v, err := strconv.ParseFloat(rest[:i], 64)
+		if err != nil {
+			return fail("bad number " + rest[:i])
+		}
+		fractional = strings.Contains(rest[:i], ".")
+		rest = strings.TrimLeft(rest[i:], " ")
+
+		next := level
+		for _, m := range _markers {
+			if strings.HasPrefix(rest, m.s) {
+				if m.level >= 0 {
+					if m.level < level {
+						return fail("components out of order")
+					}
+					next = m.level
+				}
+				if m.level == 0 {
+					hours = m.hours
+				}
+				rest = strings.TrimLeft(rest[len(m.s):], " ")
+				break
+			}
+		}
+		if next > 0 && v >= 60 {
+			return fail("minutes and seconds should be less than 60")
+		}
+		parts[next] = v
+		level = next + 1
+	}
+
+	x := parts[0] + parts[1]/60 + parts[2]/3600
+	if neg {
+		x = -x
+	}
+	if hours {
+		return AngleFromHours(x), nil
+	}
+	return AngleFromDegrees(x), nil
+}
@@ -0,0 +1,109 @@
+package mathutils
+
+import "testing"
+
+func TestAngleConstructors(t *testing.T) {
+	cases := []struct {
+		got Angle
+		exp float64 // degrees
+	}{
+		{AngleFromDegrees(-23.5), -23.5},
+		{AngleFromHours(6.5), 97.5},
+		{AngleFromDMS(true, 23, 26, 21.4), -23.439278},
+		{AngleFromDMS(true, 0, 30, 0), -0.5},
+		{AngleFromHMS(false, 12, 34, 56.7), 188.73625},
+	}
+	for _, test := range cases {
+		if !AlmostEqual(test.got.Degrees(), test.exp, 1e-6) {
+			t.Errorf("Expected: %f, got: %f", test.exp, test.got.Degrees())
+		}
+	}
+}
+
+func TestAngleDMS(t *testing.T) {
+	neg, d, m, s := AngleFromDegrees(-0.5).DMS()
+	if !neg || d != 0 || m != 30 || !AlmostEqual(s, 0, 1e-6) {
+		t.Errorf("Expected: -0°30'0\", got: %v %d %d %f", neg, d, m, s)
+	}
+}
+
+func TestParseAngle(t *testing.T) {
+	cases := []struct {
+		s   string
+		exp float64 // degrees
+	}{
+		{"12h34m56.7s", 188.73625},
+		{"-23°26'21.4\"", -23.439278},
+		{"+12:30:00", 12.5},
+		{"123d45m", 123.75},
+		{"−0°30′", -0.5},
+		{"-0.5", -0.5},
+		{"12 30 36", 12.51},
+		{"10h", 150},
+	}
+	for _, test := range cases {
+		got, err := ParseAngle(test.s)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.s, err)
+			continue
+		}
+		if !AlmostEqual(got.Degrees(), test.exp, 1e-6) {
+			t.Errorf("%s: expected: %f, got: %f", test.s, test.exp, got.Degrees())
+		}
+	}
+}
+
+func TestParseHours(t *testing.T) {
+	got, err := ParseHours("06:30:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !AlmostEqual(got.Hours(), 6.5, 1e-9) {
+		t.Errorf("Expected: 6.5, got: %f", got.Hours())
+	}
+	got, _ = ParseHours("-23°30'")
+	if !AlmostEqual(got.Degrees(), -23.5, 1e-9) {
+		t.Errorf("Expected: -23.5, got: %f", got.Degrees())
+	}
+}
+
+func TestParseAngleErrors(t *testing.T) {
+	for _, s := range []string{"", "-", "abc", "12.5:30", "12m30d", "12:60", "1:2:3:4", "12x"} {
+		if _, err := ParseAngle(s); err == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}
+
+func TestFormatAngle(t *testing.T) {
+	cases := []struct {
+		got string
+		exp string
+	}{
+		{AngleFromDMS(true, 23, 26, 21.4).FormatDMS(1), "-23°26'21.4\""},
+		{AngleFromHMS(false, 12, 34, 56.7).FormatHMS(1), "12h34m56.7s"},
+		{AngleFromHMS(false, 12, 34, 56.7).FormatHMS(0), "12h34m57s"},
+		// rounding carry
+		{AngleFromHMS(false, 5, 59, 59.99999).FormatHMS(2), "6h00m00.00s"},
+		{AngleFromDMS(false, 0, 59, 59.96).FormatDMS(1), "1°00'00.0\""},
+		// negative zero
+		{AngleFromDMS(true, 0, 0, 0.00001).FormatDMS(2), "0°00'00.00\""},
+		{AngleFromDMS(true, 0, 30, 0).FormatDMS(0), "-0°30'00\""},
+	}
+	for _, test := range cases {
+		if test.got != test.exp {
+			t.Errorf("Expected: %s, got: %s", test.exp, test.got)
+		}
+	}
+}
+
+func TestAngleRoundTrip(t *testing.T) {
+	a := AngleFromDMS(true, 23, 26, 21.4)
+	b, err := ParseAngle(a.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !AlmostEqual(a.Degrees(), b.Degrees(), 1e-9) {
+		t.Errorf("Expected: %f, got: %f", a.Degrees(), b.Degrees())
+	}
+}
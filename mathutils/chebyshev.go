@@ -0,0 +1,149 @@
+package mathutils
+
+import (
+	"errors"
+	"math"
+)
+
+// Chebyshev series approximating a function on interval [A, B]:
+//
+//	f(x) = Σ C[k] * T[k](u), u = (2x - A - B) / (B - A)
+type Chebyshev struct {
+	A float64
+	B float64
+	C []float64
+}
+
+// Given function [f], interval [a], [b] and [degree] of the polynomial,
+// calculate coefficients of Chebyshev series, interpolating f at the
+// Chebyshev nodes.
+func FitChebyshev(f func(float64) float64, a, b float64, degree int) Chebyshev {
+	n := degree + 1
+	mid, half := (a+b)/2, (b-a)/2
+	ys := make([]float64, n)
+	for j := range ys {
+		u := math.Cos(math.Pi * (float64(j) + 0.5) / float64(n))
+		ys[j] = f(mid + half*u)
+	}
+	c := make([]float64, n)
+	for k := range c {
+		s := 0.0
+		for j, y := range ys {
+			s += y * math.Cos(math.Pi*float64(k)*(float64(j)+0.5)/float64(n))
+		}
+		c[k] = 2 * s / float64(n)
+	}
+	c[0] /= 2
+	return Chebyshev{A: a, B: b, C: c}
+}
+
+// Value of the series at [x], Clenshaw's recurrence.
+func (ch Chebyshev) Eval(x float64) float64 {
+	u := (2*x - ch.A - ch.B) / (ch.B - ch.A)
+	var b1, b2 float64
+	for k := len(ch.C) - 1; k >= 1; k-- {
+		b1, b2 = 2*u*b1-b2+ch.C[k], b1
+	}
+	return u*b1 - b2 + ch.C[0]
+}
+
+// Series of the first derivative with respect to x.
+func (ch Chebyshev) Derivative() Chebyshev {
+	n := len(ch.C)
+	res := Chebyshev{A: ch.A, B: ch.B, C: make([]float64, max(n-1, 1))}
+	if n < 2 {
+		return res
+	}
+	d := make([]float64, n+1)
+	for k := n - 1; k >= 1; k-- {
+		d[k-1] = d[k+1] + 2*float64(k)*ch.C[k]
+	}
+	d[0] /= 2
+	scale := 2 / (ch.B - ch.A)
+	for k := range res.C {
+		res.C[k] = d[k] * scale
+	}
+	return res
+}
+
+// Value and the first derivative of the series at [x]. Clenshaw's recurrence
+// is differentiated along with the series, so that, unlike Derivative, it
+// does not allocate.
+func (ch Chebyshev) EvalDerivative(x float64) (y float64, dy float64) {
+	u := (2*x - ch.A - ch.B) / (ch.B - ch.A)
+	var b1, b2, d1, d2 float64
+	for k := len(ch.C) - 1; k >= 1; k-- {
+		b1, b2, d1, d2 = 2*u*b1-b2+ch.C[k], b1, 2*b1+2*u*d1-d2, d1
+	}
+	y = u*b1 - b2 + ch.C[0]
+	dy = (b1 + u*d1 - d2) * 2 / (ch.B - ch.A)
+	return y, dy
+}
+
+// Piecewise Chebyshev approximation of a function, consisting of
+// segments of equal length, which cover interval from Start to End.
+type ChebyshevTable struct {
+	Start    float64
+	End      float64
+	Segments []Chebyshev
+}
+
+// Samples a slow function [f], e.g. of Julian Date, between [start] and
+// [end] and compiles it into a table of Chebyshev series of the given
+// [degree], each covering [span].
+//
+//	// Delta-T for the 21st century, yearly segments
+//	tab, err := CompileChebyshev(deltat.DeltaT, 2451544.5, 2488069.5, 365.25, 8)
+//	dt := tab.Eval(jd)
+func CompileChebyshev(f func(float64) float64, start, end, span float64, degree int) (*ChebyshevTable, error) {
+	if end <= start || span <= 0 {
+		return nil, errors.New("chebyshev: empty interval")
+	}
+	if degree < 0 {
+		return nil, errors.New("chebyshev: negative degree")
+	}
+	n := int(math.Ceil((end - start) / span))
+	span = (end - start) / float64(n)
+	tab := &ChebyshevTable{Start: start, End: end, Segments: make([]Chebyshev, n)}
+	for i := range tab.Segments {
+		a := start + float64(i)*span
+		tab.Segments[i] = FitChebyshev(f, a, a+span, degree)
+	}
+	return tab, nil
+}
+
+// Whether [x] is covered by the table.
+func (tab *ChebyshevTable) Contains(x float64) bool {
+	return x >= tab.Start && x <= tab.End
+}
+
+func (tab *ChebyshevTable) segment(x float64) (Chebyshev, bool) {
+	if !tab.Contains(x) {
+		return Chebyshev{}, false
+	}
+	n := len(tab.Segments)
+	i := int(float64(n) * (x - tab.Start) / (tab.End - tab.Start))
+	if i >= n {
+		i = n - 1
+	}
+	return tab.Segments[i], true
+}
+
+// Value of the function at [x], NaN outside the table.
+func (tab *ChebyshevTable) Eval(x float64) float64 {
+	seg, ok := tab.segment(x)
+	if !ok {
+		return math.NaN()
+	}
+	return seg.Eval(x)
+}
+
+// Value and the first derivative of the function at [x], NaN outside the
+// table.
+func (tab *ChebyshevTable) EvalDerivative(x float64) (y float64, dy float64) {
+	seg, ok := tab.segment(x)
+	if !ok {
+		return math.NaN(), math.NaN()
+	}
+	return seg.EvalDerivative(x)
+}
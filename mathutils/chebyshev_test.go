@@ -0,0 +1,80 @@
+package mathutils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChebyshevPolynomial(t *testing.T) {
+	// polynomial of degree 3 is reproduced exactly
+	f := func(x float64) float64 { return 4*x*x*x - 3*x*x + 2 }
+	ch := FitChebyshev(f, -2, 5, 3)
+	for _, x := range []float64{-2, -0.3, 1.7, 5} {
+		if got := ch.Eval(x); !AlmostEqual(got, f(x), 1e-9) {
+			t.Errorf("Expected: %f, got: %f", f(x), got)
+		}
+		exp := 12*x*x - 6*x
+		if _, got := ch.EvalDerivative(x); !AlmostEqual(got, exp, 1e-9) {
+			t.Errorf("Derivative expected: %f, got: %f", exp, got)
+		}
+	}
+}
+
+func TestChebyshevSine(t *testing.T) {
+	ch := FitChebyshev(math.Sin, 0, math.Pi, 12)
+	for x := 0.0; x <= math.Pi; x += 0.1 {
+		y, dy := ch.EvalDerivative(x)
+		if !AlmostEqual(y, math.Sin(x), 1e-10) {
+			t.Errorf("Expected: %f, got: %f", math.Sin(x), y)
+		}
+		if !AlmostEqual(dy, math.Cos(x), 1e-9) {
+			t.Errorf("Derivative expected: %f, got: %f", math.Cos(x), dy)
+		}
+	}
+}
+
+func TestCompileChebyshev(t *testing.T) {
+	tab, err := CompileChebyshev(math.Cos, 0, 100, 3, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for x := 0.0; x <= 100; x += 0.37 {
+		if got := tab.Eval(x); !AlmostEqual(got, math.Cos(x), 1e-10) {
+			t.Errorf("Expected: %f, got: %f", math.Cos(x), got)
+		}
+	}
+	if got := tab.Eval(100); !AlmostEqual(got, math.Cos(100), 1e-10) {
+		t.Errorf("Expected: %f, got: %f", math.Cos(100), got)
+	}
+	if !math.IsNaN(tab.Eval(100.5)) || !math.IsNaN(tab.Eval(-0.5)) {
+		t.Error("Expected NaN outside the table")
+	}
+}
+
+func TestCompileChebyshevErrors(t *testing.T) {
+	if _, err := CompileChebyshev(math.Cos, 10, 0, 1, 5); err == nil {
+		t.Error("Expected error for empty interval")
+	}
+	if _, err := CompileChebyshev(math.Cos, 0, 10, 1, -1); err == nil {
+		t.Error("Expected error for negative degree")
+	}
+}
+
+func TestChebyshevTableDerivative(t *testing.T) {
+	tab, err := CompileChebyshev(math.Sin, 0, 100, 3, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for x := 0.0; x <= 100; x += 0.37 {
+		_, dy := tab.EvalDerivative(x)
+		seg, _ := tab.segment(x)
+		exp := seg.Derivative().Eval(x)
+		if !AlmostEqual(dy, exp, 1e-12) {
+			t.Errorf("Expected: %f, got: %f", exp, dy)
+		}
+	}
+	allocs := testing.AllocsPerRun(100, func() { tab.EvalDerivative(42) })
+	if allocs != 0 {
+		t.Errorf("Expected no allocations, got: %f", allocs)
+	}
+}
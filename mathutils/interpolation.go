@@ -0,0 +1,237 @@
+package mathutils
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// Maximal number of iterations while searching extremum and zero of
+// a tabulated function.
+const _MAX_ITER = 50
+
+// Required precision of interpolating factor.
+const _N_PRECISION = 1e-12
+
+// Lagrange interpolation. Given arguments [xs] and values [ys] of a function,
+// which need not be evenly spaced, calculate the value for [x].
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, formula 3.12.
+func Lagrange(xs, ys []float64, x float64) float64 {
+	res := 0.0
+	for i := range xs {
+		c := 1.0
+		for j := range xs {
+			if j != i {
+				c *= (x - xs[j]) / (xs[i] - xs[j])
+			}
+		}
+		res += c * ys[i]
+	}
+	return res
+}
+
+// Table of a function values, tabulated for evenly spaced arguments:
+// Y[i] corresponds to X0 + i * Step.
+type EvenTable struct {
+	X0   float64
+	Step float64
+	Y    []float64
+}
+
+// Everett interpolation formula, which uses second and fourth central
+// differences and gives the same result as Bessel's formula truncated
+// after the same differences. Near the ends of the table, where there are
+// not enough neighbours, higher differences are dropped. Arguments outside
+// the table are extrapolated from the nearest interval.
+func (t EvenTable) Everett(x float64) float64 {
+	n := len(t.Y)
+	if n == 1 {
+		return t.Y[0]
+	}
+	f := (x - t.X0) / t.Step
+	i := int(math.Floor(f))
+	if i < 0 {
+		i = 0
+	} else if i > n-2 {
+		i = n - 2
+	}
+	p := f - float64(i)
+	q := 1 - p
+	y := func(k int) float64 { return t.Y[i+k] }
+
+	res := q*y(0) + p*y(1)
+	if i < 1 || i > n-3 {
+		return res
+	}
+	d20 := y(-1) - 2*y(0) + y(1)
+	d21 := y(0) - 2*y(1) + y(2)
+	res += q*(q*q-1)/6*d20 + p*(p*p-1)/6*d21
+	if i < 2 || i > n-4 {
+		return res
+	}
+	d40 := y(-2) - 4*y(-1) + 6*y(0) - 4*y(1) + y(2)
+	d41 := y(-1) - 4*y(0) + 6*y(1) - 4*y(2) + y(3)
+	return res + q*(q*q-1)*(q*q-4)/120*d40 + p*(p*p-1)*(p*p-4)/120*d41
+}
+
+// Finds root of equation f(n) = 0 by Newton's method, given function
+// returning f and its derivative.
+func newton(f func(float64) (float64, float64)) (float64, bool) {
+	n := 0.0
+	for i := 0; i < _MAX_ITER; i++ {
+		y, dy := f(n)
+		if dy == 0 {
+			return n, false
+		}
+		dn := y / dy
+		n -= dn
+		if math.Abs(dn) < _N_PRECISION {
+			return n, true
+		}
+	}
+	return n, false
+}
+
+// Given three consecutive tabular values, calculate value for interpolating
+// factor [n], -1 <= n <= 1, in units of the table step, counted from the
+// central argument.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, chapter 3.
+func Interpolate3(y1, y2, y3, n float64) float64 {
+	a, b := y2-y1, y3-y2
+	c := b - a
+	return y2 + n/2*(a+b+n*c)
+}
+
+// Given three consecutive tabular values, calculate interpolating factor
+// [n] and value [ym] of the extremum. The extremum may lie outside the
+// table, then |n| > 1. The third value is false if the values lie on a
+// straight line, which has no extremum.
+func Extremum3(y1, y2, y3 float64) (n float64, ym float64, ok bool) {
+	a, b := y2-y1, y3-y2
+	c := b - a
+	if c == 0 {
+		return math.NaN(), math.NaN(), false
+	}
+	return -(a + b) / (2 * c), y2 - (a+b)*(a+b)/(8*c), true
+}
+
+// Given three consecutive tabular values, calculate interpolating factor
+// of the function's zero, nearest to the central argument. The second
+// value is false if the search fails.
+func Zero3(y1, y2, y3 float64) (float64, bool) {
+	a, b := y2-y1, y3-y2
+	c := b - a
+	return newton(func(n float64) (float64, float64) {
+		return y2 + n/2*(a+b+n*c), (a+b)/2 + n*c
+	})
+}
+
+// Differences of five consecutive tabular values, Meeus notation.
+type _diff5 struct {
+	y3, b, c, f, h, j, k float64
+}
+
+func diff5(y1, y2, y3, y4, y5 float64) _diff5 {
+	a, b, c, d := y2-y1, y3-y2, y4-y3, y5-y4
+	e, f, g := b-a, c-b, d-c
+	h, j := f-e, g-f
+	return _diff5{y3: y3, b: b, c: c, f: f, h: h, j: j, k: j - h}
+}
+
+func (d _diff5) value(n float64) float64 {
+	n2 := n * n
+	return d.y3 + n/2*(d.b+d.c) + n2/2*d.f + n*(n2-1)/12*(d.h+d.j) + n2*(n2-1)/24*d.k
+}
+
+func (d _diff5) derivative(n float64) float64 {
+	n2 := n * n
+	return (d.b+d.c)/2 + n*d.f + (3*n2-1)/12*(d.h+d.j) + (2*n2-1)*n/12*d.k
+}
+
+func (d _diff5) derivative2(n float64) float64 {
+	return d.f + n/2*(d.h+d.j) + (6*n*n-1)/12*d.k
+}
+
+// Given five consecutive tabular values, calculate value for interpolating
+// factor [n], -2 <= n <= 2, counted from the central argument.
+func Interpolate5(y1, y2, y3, y4, y5, n float64) float64 {
+	return diff5(y1, y2, y3, y4, y5).value(n)
+}
+
+// Given five consecutive tabular values, calculate interpolating factor
+// [n] and value [ym] of the extremum nearest to the central argument.
+// The third value is false if the search fails.
+func Extremum5(y1, y2, y3, y4, y5 float64) (n float64, ym float64, ok bool) {
+	d := diff5(y1, y2, y3, y4, y5)
+	n, ok = newton(func(n float64) (float64, float64) {
+		return d.derivative(n), d.derivative2(n)
+	})
+	return n, d.value(n), ok
+}
+
+// Given five consecutive tabular values, calculate interpolating factor
+// of the function's zero, nearest to the central argument. The second
+// value is false if the search fails.
+func Zero5(y1, y2, y3, y4, y5 float64) (float64, bool) {
+	d := diff5(y1, y2, y3, y4, y5)
+	return newton(func(n float64) (float64, float64) {
+		return d.value(n), d.derivative(n)
+	})
+}
+
+// Natural cubic spline.
+type Spline struct {
+	xs, ys []float64
+	// second derivatives at the knots
+	m []float64
+}
+
+// Given arguments [xs] in ascending order and values [ys] of a function,
+// build natural cubic spline, with zero second derivatives at the ends.
+func NewSpline(xs, ys []float64) (*Spline, error) {
+	n := len(xs)
+	if n != len(ys) {
+		return nil, errors.New("spline: arguments and values differ in length")
+	}
+	if n < 2 {
+		return nil, errors.New("spline: at least two points required")
+	}
+	for i := 1; i < n; i++ {
+		if xs[i] <= xs[i-1] {
+			return nil, errors.New("spline: arguments must be in ascending order")
+		}
+	}
+	// tridiagonal system, Thomas algorithm
+	m := make([]float64, n)
+	u := make([]float64, n)
+	for i := 1; i < n-1; i++ {
+		sig := (xs[i] - xs[i-1]) / (xs[i+1] - xs[i-1])
+		p := sig*m[i-1] + 2
+		m[i] = (sig - 1) / p
+		d := (ys[i+1]-ys[i])/(xs[i+1]-xs[i]) - (ys[i]-ys[i-1])/(xs[i]-xs[i-1])
+		u[i] = (6*d/(xs[i+1]-xs[i-1]) - sig*u[i-1]) / p
+	}
+	m[n-1] = 0
+	for i := n - 2; i >= 0; i-- {
+		m[i] = m[i]*m[i+1] + u[i]
+	}
+	return &Spline{xs: xs, ys: ys, m: m}, nil
+}
+
+// Value of the spline for [x]. Outside the knots the end polynomials are
+// extrapolated.
+func (s *Spline) At(x float64) float64 {
+	n := len(s.xs)
+	i := sort.SearchFloat64s(s.xs, x) - 1
+	if i < 0 {
+		i = 0
+	} else if i > n-2 {
+		i = n - 2
+	}
+	h := s.xs[i+1] - s.xs[i]
+	a := (s.xs[i+1] - x) / h
+	b := (x - s.xs[i]) / h
+	return a*s.ys[i] + b*s.ys[i+1] + ((a*a*a-a)*s.m[i]+(b*b*b-b)*s.m[i+1])*h*h/6
+}
@@ -0,0 +1,134 @@
+package mathutils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLagrange(t *testing.T) {
+	// cubic is reproduced exactly by four points
+	f := func(x float64) float64 { return 2*x*x*x - x + 3 }
+	xs := []float64{-1, 0.5, 2, 4}
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = f(x)
+	}
+	for _, x := range []float64{-0.7, 1.3, 3.9} {
+		if got := Lagrange(xs, ys, x); !AlmostEqual(got, f(x), 1e-9) {
+			t.Errorf("Expected: %f, got: %f", f(x), got)
+		}
+	}
+}
+
+func TestEverett(t *testing.T) {
+	tab := EvenTable{X0: 0, Step: 0.1}
+	for i := 0; i <= 20; i++ {
+		tab.Y = append(tab.Y, math.Sin(float64(i)*0.1))
+	}
+	cases := []struct {
+		x   float64
+		tol float64
+	}{
+		{1.03, 1e-8}, // middle of the table, fourth differences
+		{0.13, 1e-6}, // second differences
+		{0.05, 2e-3}, // linear interpolation at the edge
+	}
+	for _, test := range cases {
+		if got := tab.Everett(test.x); !AlmostEqual(got, math.Sin(test.x), test.tol) {
+			t.Errorf("x = %f. Expected: %f, got: %f", test.x, math.Sin(test.x), got)
+		}
+	}
+}
+
+func TestInterpolate3(t *testing.T) {
+	// Meeus, example 3.a: Moon's distance
+	got := Interpolate3(0.884226, 0.877366, 0.870531, 4.35/24)
+	if !AlmostEqual(got, 0.876125, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 0.876125, got)
+	}
+}
+
+func TestExtremum3(t *testing.T) {
+	// Meeus, example 3.b: distance of Mars
+	n, ym, ok := Extremum3(1.3814294, 1.3812213, 1.3812453)
+	if !ok {
+		t.Fatal("Expected extremum")
+	}
+	if !AlmostEqual(n, 0.3966, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", 0.3966, n)
+	}
+	if !AlmostEqual(ym, 1.3812030, 1e-7) {
+		t.Errorf("Expected: %f, got: %f", 1.3812030, ym)
+	}
+	// straight line has no extremum
+	if _, _, ok := Extremum3(1, 2, 3); ok {
+		t.Errorf("Expected no extremum of a straight line")
+	}
+}
+
+func TestZero3(t *testing.T) {
+	// Meeus, example 3.c: declination of Mercury
+	y1 := AngleFromDMS(true, 0, 28, 13.4).Degrees()
+	y2 := AngleFromDMS(false, 0, 6, 46.3).Degrees()
+	y3 := AngleFromDMS(false, 0, 38, 23.2).Degrees()
+	n, ok := Zero3(y1, y2, y3)
+	if !ok || !AlmostEqual(n, -0.20127, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", -0.20127, n)
+	}
+}
+
+func TestFivePoints(t *testing.T) {
+	// quartic polynomial is reproduced exactly
+	f := func(n float64) float64 { return 0.1*n*n*n*n - 0.5*n*n*n + 0.3*n*n + 2*n - 1 }
+	y := [5]float64{f(-2), f(-1), f(0), f(1), f(2)}
+
+	if got := Interpolate5(y[0], y[1], y[2], y[3], y[4], 0.37); !AlmostEqual(got, f(0.37), 1e-12) {
+		t.Errorf("Expected: %f, got: %f", f(0.37), got)
+	}
+	n, ok := Zero5(y[0], y[1], y[2], y[3], y[4])
+	if !ok || !AlmostEqual(f(n), 0, 1e-12) {
+		t.Errorf("Expected zero, got: %f at n = %f", f(n), n)
+	}
+	// extremum of a parabola
+	g := func(n float64) float64 { return 1 - (n-0.25)*(n-0.25) }
+	n, ym, ok := Extremum5(g(-2), g(-1), g(0), g(1), g(2))
+	if !ok || !AlmostEqual(n, 0.25, 1e-9) || !AlmostEqual(ym, 1, 1e-9) {
+		t.Errorf("Expected: 0.25, 1.0, got: %f, %f", n, ym)
+	}
+	// straight line has no extremum
+	if _, _, ok := Extremum5(1, 2, 3, 4, 5); ok {
+		t.Errorf("Expected no extremum of a straight line")
+	}
+}
+
+func TestSpline(t *testing.T) {
+	xs := []float64{0, 0.5, 1, 1.5, 2, 2.5, 3}
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = math.Sin(x)
+	}
+	s, err := NewSpline(xs, ys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, x := range xs {
+		if got := s.At(x); got != ys[i] {
+			t.Errorf("Knot %f. Expected: %f, got: %f", x, ys[i], got)
+		}
+	}
+	if got := s.At(1.25); !AlmostEqual(got, math.Sin(1.25), 1e-3) {
+		t.Errorf("Expected: %f, got: %f", math.Sin(1.25), got)
+	}
+}
+
+func TestSplineErrors(t *testing.T) {
+	if _, err := NewSpline([]float64{0, 1}, []float64{0}); err == nil {
+		t.Error("Expected error for different lengths")
+	}
+	if _, err := NewSpline([]float64{0}, []float64{0}); err == nil {
+		t.Error("Expected error for a single point")
+	}
+	if _, err := NewSpline([]float64{0, 2, 1}, []float64{0, 1, 2}); err == nil {
+		t.Error("Expected error for unordered arguments")
+	}
+}
@@ -0,0 +1,236 @@
+package mathutils
+
+import (
+	"context"
+	"errors"
+	"math"
+)
+
+// Default tolerance of refined arguments, days in case of Julian Dates
+// (about 1 ms).
+const _TOLERANCE = 1e-8
+
+// Maximal number of Brent's iterations.
+const _MAX_BRENT_ITER = 100
+
+// Returned by Brent when values of the function at the ends of an interval
+// have the same sign.
+var ErrNoBracket = errors.New("mathutils: root is not bracketed")
+
+// Returned by Brent when the root is not refined to the tolerance after
+// the maximal number of iterations.
+var ErrNoConvergence = errors.New("mathutils: root search does not converge")
+
+// Range of a scan, e.g. Julian Dates. The function is sampled every Step
+// between Start and End, intervals which contain an event are refined
+// to Tolerance; zero Tolerance means the default, 1e-8.
+//
+// Step should be small enough for at most one event to occur in every
+// interval; an even number of events inside a single step is missed.
+type Scan struct {
+	Start     float64
+	End       float64
+	Step      float64
+	Tolerance float64
+}
+
+func (s Scan) tolerance() float64 {
+	if s.Tolerance > 0 {
+		return s.Tolerance
+	}
+	return _TOLERANCE
+}
+
+// Calls [visit] for every step of the scan with the step ends and function
+// values, stops when [visit] returns false or the context is cancelled.
+func (s Scan) walk(ctx context.Context, f func(float64) float64, visit func(t0, t1, y0, y1 float64) bool) error {
+	if s.Step <= 0 {
+		return errors.New("mathutils: scan step should be positive")
+	}
+	y0 := f(s.Start)
+	for t := s.Start; t < s.End; t += s.Step {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		t1 := math.Min(t+s.Step, s.End)
+		y1 := f(t1)
+		if !visit(t, t1, y0, y1) {
+			break
+		}
+		y0 = y1
+	}
+	return nil
+}
+
+// Finds root of [f] between [a] and [b] with Brent's method, given that
+// f(a) and f(b) have opposite signs, to tolerance [tol]. If the tolerance
+// is not reached in 100 iterations, the last approximation is returned
+// with ErrNoConvergence.
+//
+// Source: R.P.Brent, "Algorithms for Minimization without Derivatives", 1973.
+func Brent(f func(float64) float64, a, b, tol float64) (float64, error) {
+	fa, fb := f(a), f(b)
+	if fa == 0 {
+		return a, nil
+	}
+	if fb == 0 {
+		return b, nil
+	}
+	if (fa < 0) == (fb < 0) {
+		return math.NaN(), ErrNoBracket
+	}
+	c, fc := a, fa
+	d := b - a
+	e := d
+	for i := 0; i < _MAX_BRENT_ITER; i++ {
+		if (fb < 0) == (fc < 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		tol1 := 2*1e-16*math.Abs(b) + tol/2
+		m := (c - b) / 2
+		if math.Abs(m) <= tol1 || fb == 0 {
+			return b, nil
+		}
+		if math.Abs(e) >= tol1 && math.Abs(fa) > math.Abs(fb) {
+			// inverse quadratic interpolation or secant
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				q0 := fa / fc
+				r := fb / fc
+				p = s * (2*m*q0*(q0-r) - (b-a)*(r-1))
+				q = (q0 - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			} else {
+				p = -p
+			}
+			if 2*p < math.Min(3*m*q-math.Abs(tol1*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				// bisection
+				d = m
+				e = d
+			}
+		} else {
+			d = m
+			e = d
+		}
+		a, fa = b, fb
+		if math.Abs(d) > tol1 {
+			b += d
+		} else {
+			b += math.Copysign(tol1, m)
+		}
+		fb = f(b)
+	}
+	return b, ErrNoConvergence
+}
+
+// A moment when function crosses a threshold.
+type Crossing struct {
+	// argument, e.g. Julian Date
+	X float64
+	// true if the function increases, e.g. at rising of a body
+	Rising bool
+}
+
+// Finds every crossing of [threshold] by function [f] within the [scan]
+// range, in ascending order. When the context is cancelled or refinement
+// of a crossing fails, returns the crossings found so far along with the
+// error.
+//
+//	// sunrises and sunsets for a month, altitude is a function of JD
+//	scan := Scan{Start: jd, End: jd + 30, Step: 1.0 / 48}
+//	events, err := FindCrossings(ctx, altitude, -0.8333, scan)
+func FindCrossings(ctx context.Context, f func(float64) float64, threshold float64, scan Scan) ([]Crossing, error) {
+	g := func(x float64) float64 { return f(x) - threshold }
+	tol := scan.tolerance()
+	var res []Crossing
+	var refineErr error
+	err := scan.walk(ctx, g, func(t0, t1, y0, y1 float64) bool {
+		if (y0 < 0) != (y1 < 0) {
+			x, err := Brent(g, t0, t1, tol)
+			if err != nil {
+				refineErr = err
+				return false
+			}
+			res = append(res, Crossing{X: x, Rising: y1 > y0})
+		}
+		return true
+	})
+	if err == nil {
+		err = refineErr
+	}
+	return res, err
+}
+
+// Local extremum of a function.
+type Extremum struct {
+	// argument, e.g. Julian Date
+	X float64
+	// value of the function
+	Y float64
+	// true for maximum, false for minimum
+	Maximum bool
+}
+
+// Golden section search for maximum of [f] between [a] and [b].
+func goldenMax(f func(float64) float64, a, b, tol float64) float64 {
+	r := (math.Sqrt(5) - 1) / 2
+	x1 := b - r*(b-a)
+	x2 := a + r*(b-a)
+	f1, f2 := f(x1), f(x2)
+	for b-a > tol {
+		if f1 < f2 {
+			a, x1, f1 = x1, x2, f2
+			x2 = a + r*(b-a)
+			f2 = f(x2)
+		} else {
+			b, x2, f2 = x2, x1, f1
+			x1 = b - r*(b-a)
+			f1 = f(x1)
+		}
+	}
+	return (a + b) / 2
+}
+
+// Finds every local maximum and minimum of function [f] within the [scan]
+// range, in ascending order. Extrema at the ends of the range are not
+// reported. When the context is cancelled, returns the extrema found so far
+// along with the context error.
+func FindExtrema(ctx context.Context, f func(float64) float64, scan Scan) ([]Extremum, error) {
+	tol := scan.tolerance()
+	var res []Extremum
+	var prev, prevY float64
+	first := true
+	err := scan.walk(ctx, f, func(t0, t1, y0, y1 float64) bool {
+		if !first {
+			max := y0 > prevY && y0 >= y1
+			min := y0 < prevY && y0 <= y1
+			if max || min {
+				g := f
+				if min {
+					g = func(x float64) float64 { return -f(x) }
+				}
+				x := goldenMax(g, prev, t1, tol)
+				res = append(res, Extremum{X: x, Y: f(x), Maximum: max})
+			}
+		}
+		first = false
+		prev, prevY = t0, y0
+		return true
+	})
+	return res, err
+}
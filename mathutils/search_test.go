@@ -0,0 +1,124 @@
+package mathutils
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+func TestBrent(t *testing.T) {
+	f := func(x float64) float64 { return x*x*x - 2*x - 5 }
+	got, err := Brent(f, 2, 3, 1e-12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !AlmostEqual(got, 2.0945514815423265, 1e-11) {
+		t.Errorf("Expected: %.12f, got: %.12f", 2.0945514815423265, got)
+	}
+}
+
+func TestBrentNoBracket(t *testing.T) {
+	_, err := Brent(math.Cos, 2, 4, 1e-9)
+	if err != ErrNoBracket {
+		t.Errorf("Expected ErrNoBracket, got: %v", err)
+	}
+}
+
+func TestBrentNoConvergence(t *testing.T) {
+	// zero tolerance near the root at zero can not be reached
+	_, err := Brent(math.Cbrt, -1e300, 1e299, 0)
+	if err != ErrNoConvergence {
+		t.Errorf("Expected ErrNoConvergence, got: %v", err)
+	}
+	scan := Scan{Start: -1e300, End: 1e299, Step: 2e300, Tolerance: 1e-300}
+	if _, err := FindCrossings(context.Background(), math.Cbrt, 0, scan); err != ErrNoConvergence {
+		t.Errorf("Expected ErrNoConvergence, got: %v", err)
+	}
+}
+
+func TestFindCrossings(t *testing.T) {
+	scan := Scan{Start: 0, End: 10, Step: 0.3, Tolerance: 1e-10}
+	got, err := FindCrossings(context.Background(), math.Sin, 0.5, scan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []Crossing{
+		{math.Pi / 6, true},
+		{5 * math.Pi / 6, false},
+		{13 * math.Pi / 6, true},
+		{17 * math.Pi / 6, false},
+	}
+	if len(got) != len(exp) {
+		t.Fatalf("Expected %d crossings, got: %v", len(exp), got)
+	}
+	for i := range exp {
+		if got[i].Rising != exp[i].Rising || !AlmostEqual(got[i].X, exp[i].X, 1e-9) {
+			t.Errorf("Expected: %v, got: %v", exp[i], got[i])
+		}
+	}
+}
+
+func TestFindExtrema(t *testing.T) {
+	scan := Scan{Start: 0, End: 10, Step: 0.25, Tolerance: 1e-9}
+	got, err := FindExtrema(context.Background(), math.Cos, scan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []Extremum{
+		{math.Pi, -1, false},
+		{2 * math.Pi, 1, true},
+		{3 * math.Pi, -1, false},
+	}
+	if len(got) != len(exp) {
+		t.Fatalf("Expected %d extrema, got: %v", len(exp), got)
+	}
+	for i := range exp {
+		if got[i].Maximum != exp[i].Maximum || !AlmostEqual(got[i].X, exp[i].X, 1e-6) || !AlmostEqual(got[i].Y, exp[i].Y, 1e-9) {
+			t.Errorf("Expected: %v, got: %v", exp[i], got[i])
+		}
+	}
+}
+
+func TestSearchCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	f := func(x float64) float64 {
+		calls++
+		if calls == 10 {
+			cancel()
+		}
+		return math.Sin(x)
+	}
+	_, err := FindCrossings(ctx, f, 0, Scan{Start: 0, End: 1000, Step: 0.1})
+	if err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got: %v", err)
+	}
+	if calls > 100 {
+		t.Errorf("Scan was not stopped, %d calls", calls)
+	}
+}
+
+func TestSearchBadStep(t *testing.T) {
+	if _, err := FindExtrema(context.Background(), math.Sin, Scan{Start: 0, End: 1}); err == nil {
+		t.Error("Expected error for zero step")
+	}
+}
+
+func TestFindCrossingsRefineError(t *testing.T) {
+	// function changes after the scan, so the bracket is lost
+	calls := 0
+	f := func(x float64) float64 {
+		calls++
+		if calls > 2 {
+			return 1
+		}
+		return x - 0.5
+	}
+	got, err := FindCrossings(context.Background(), f, 0, Scan{Start: 0, End: 1, Step: 1})
+	if err != ErrNoBracket {
+		t.Errorf("Expected ErrNoBracket, got: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Expected no crossings, got: %v", got)
+	}
+}
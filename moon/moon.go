@@ -0,0 +1,170 @@
+// Geocentric position of the Moon: ecliptic longitude, latitude, distance
+// and horizontal parallax, as well as the lunar node and perigee.
+//
+// The theory is a truncated ELP-2000/82, with accuracy of about 10 arcseconds
+// in longitude and 4 arcseconds in latitude.
+//
+// All functions accept Julian Ephemeris Date (JDE). To obtain it, correct UT
+// Julian Date with deltat.DeltaT.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, chapter 47.
+package moon
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/nutequ"
+)
+
+// Earth's equatorial radius, km
+const EARTH_RADIUS = 6378.14
+
+// Geocentric position of the Moon.
+type Position struct {
+	// ecliptic longitude, arc-degrees
+	Lon float64
+	// ecliptic latitude, arc-degrees
+	Lat float64
+	// distance between centers of the Earth and the Moon, km
+	Dist float64
+	// equatorial horizontal parallax, arc-degrees
+	Parallax float64
+}
+
+// Mean elements of the lunar orbit, arc-degrees.
+type _Elements struct {
+	lm float64 // Moon's mean longitude
+	d  float64 // mean elongation of the Moon
+	m  float64 // Sun's mean anomaly
+	mp float64 // Moon's mean anomaly
+	f  float64 // Moon's argument of latitude
+}
+
+func centuries(jde float64) float64 {
+	return (jde - julian.J2000) / julian.DAYS_PER_CENT
+}
+
+func meanElements(t float64) _Elements {
+	t2 := t * t
+	t3 := t2 * t
+	t4 := t3 * t
+	return _Elements{
+		lm: mathutils.ReduceDeg(218.3164477 + 481267.88123421*t - 0.0015786*t2 + t3/538841 - t4/65194000),
+		d:  mathutils.ReduceDeg(297.8501921 + 445267.1114034*t - 0.0018819*t2 + t3/545868 - t4/113065000),
+		m:  mathutils.ReduceDeg(357.5291092 + 35999.0502909*t - 0.0001536*t2 + t3/24490000),
+		mp: mathutils.ReduceDeg(134.9633964 + 477198.8675055*t + 0.0087414*t2 + t3/69699 - t4/14712000),
+		f:  mathutils.ReduceDeg(93.2720950 + 483202.0175233*t - 0.0036539*t2 - t3/3526000 + t4/863310000),
+	}
+}
+
+// Multiplier for terms depending on the Sun's mean anomaly, which takes
+// into account decreasing eccentricity of the Earth's orbit.
+func eccentricity(m int, e float64) float64 {
+	switch m {
+	case 1, -1:
+		return e
+	case 2, -2:
+		return e * e
+	}
+	return 1
+}
+
+// Given [jde], Julian Ephemeris Date, calculate geometric position of the
+// Moon, referred to the mean equinox of the date.
+func Geocentric(jde float64) Position {
+	t := centuries(jde)
+	el := meanElements(t)
+	d := mathutils.Radians(el.d)
+	m := mathutils.Radians(el.m)
+	mp := mathutils.Radians(el.mp)
+	f := mathutils.Radians(el.f)
+	e := 1 - 0.002516*t - 0.0000074*t*t
+
+	var sl, sr, sb float64
+	for _, term := range _TERMS_LR {
+		arg := float64(term.d)*d + float64(term.m)*m + float64(term.mp)*mp + float64(term.f)*f
+		k := eccentricity(term.m, e)
+		sl += term.sl * k * math.Sin(arg)
+		sr += term.sr * k * math.Cos(arg)
+	}
+	for _, term := range _TERMS_B {
+		arg := float64(term.d)*d + float64(term.m)*m + float64(term.mp)*mp + float64(term.f)*f
+		sb += term.sb * eccentricity(term.m, e) * math.Sin(arg)
+	}
+
+	// additive terms: action of Venus, Jupiter and flattening of the Earth
+	a1 := mathutils.Radians(119.75 + 131.849*t)
+	a2 := mathutils.Radians(53.09 + 479264.290*t)
+	a3 := mathutils.Radians(313.45 + 481266.484*t)
+	lm := mathutils.Radians(el.lm)
+	sl += 3958*math.Sin(a1) + 1962*math.Sin(lm-f) + 318*math.Sin(a2)
+	sb += -2235*math.Sin(lm) +
+		382*math.Sin(a3) +
+		175*math.Sin(a1-f) +
+		175*math.Sin(a1+f) +
+		127*math.Sin(lm-mp) -
+		115*math.Sin(lm+mp)
+
+	dist := 385000.56 + sr/1000
+	return Position{
+		Lon:      mathutils.ReduceDeg(el.lm + sl/1e6),
+		Lat:      sb / 1e6,
+		Dist:     dist,
+		Parallax: mathutils.Degrees(math.Asin(EARTH_RADIUS / dist)),
+	}
+}
+
+// Given [jde], Julian Ephemeris Date, calculate apparent position of the
+// Moon, corrected for nutation in longitude and referred to the true
+// equinox of the date.
+func Apparent(jde float64) Position {
+	pos := Geocentric(jde)
+	dpsi, _ := nutequ.Nutation(jde)
+	pos.Lon = mathutils.ReduceDeg(pos.Lon + dpsi)
+	return pos
+}
+
+// Given [jde], Julian Ephemeris Date, calculate apparent geocentric right
+// ascension and declination of the Moon in arc-degrees.
+func Equatorial(jde float64) (ra float64, dec float64) {
+	pos := Apparent(jde)
+	_, deps := nutequ.Nutation(jde)
+	return coords.EclipticToEquatorial(pos.Lon, pos.Lat, nutequ.TrueObliquity(jde, deps))
+}
+
+// Given [jde], Julian Ephemeris Date, calculate longitude of the mean
+// ascending node of the lunar orbit in arc-degrees.
+func MeanNode(jde float64) float64 {
+	t := centuries(jde)
+	t2 := t * t
+	t3 := t2 * t
+	return mathutils.ReduceDeg(125.0445479 - 1934.1362891*t + 0.0020754*t2 + t3/467441 - t3*t/60616000)
+}
+
+// Given [jde], Julian Ephemeris Date, calculate longitude of the true
+// ascending node of the lunar orbit in arc-degrees.
+func TrueNode(jde float64) float64 {
+	el := meanElements(centuries(jde))
+	d := mathutils.Radians(el.d)
+	m := mathutils.Radians(el.m)
+	mp := mathutils.Radians(el.mp)
+	f := mathutils.Radians(el.f)
+	corr := -1.4979*math.Sin(2*(d-f)) -
+		0.1500*math.Sin(m) -
+		0.1226*math.Sin(2*d) +
+		0.1176*math.Sin(2*f) -
+		0.0801*math.Sin(2*(mp-f))
+	return mathutils.ReduceDeg(MeanNode(jde) + corr)
+}
+
+// Given [jde], Julian Ephemeris Date, calculate longitude of the mean
+// perigee of the lunar orbit in arc-degrees.
+func MeanPerigee(jde float64) float64 {
+	t := centuries(jde)
+	t2 := t * t
+	t3 := t2 * t
+	return mathutils.ReduceDeg(83.3532465 + 4069.0137287*t - 0.0103200*t2 - t3/80053 + t3*t/18999000)
+}
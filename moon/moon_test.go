@@ -0,0 +1,71 @@
+package moon
+
+import (
+	"math"
+	"testing"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// 1992 April 12.0 TD, J.Meeus, "Astronomical Algorithms", example 47.a
+const _JDE = 2448724.5
+
+func TestGeocentric(t *testing.T) {
+	pos := Geocentric(_JDE)
+	if !mathutils.AlmostEqual(pos.Lon, 133.162655, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 133.162655, pos.Lon)
+	}
+	if !mathutils.AlmostEqual(pos.Lat, -3.229126, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", -3.229126, pos.Lat)
+	}
+	if !mathutils.AlmostEqual(pos.Dist, 368409.7, 0.1) {
+		t.Errorf("Expected: %f, got: %f", 368409.7, pos.Dist)
+	}
+	if !mathutils.AlmostEqual(pos.Parallax, 0.991990, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 0.991990, pos.Parallax)
+	}
+}
+
+func TestApparent(t *testing.T) {
+	pos := Apparent(_JDE)
+	if !mathutils.AlmostEqual(pos.Lon, 133.167265, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", 133.167265, pos.Lon)
+	}
+}
+
+func TestEquatorial(t *testing.T) {
+	ra, dec := Equatorial(_JDE)
+	if !mathutils.AlmostEqual(ra, 134.688470, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", 134.688470, ra)
+	}
+	if !mathutils.AlmostEqual(dec, 13.768368, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", 13.768368, dec)
+	}
+}
+
+func TestMeanNode(t *testing.T) {
+	if got := MeanNode(julian.J2000); !mathutils.AlmostEqual(got, 125.0445479, 1e-7) {
+		t.Errorf("Expected: %f, got: %f", 125.0445479, got)
+	}
+	// the node regresses by about 19.34 degrees per year
+	got := mathutils.ReduceDeg(MeanNode(julian.J2000) - MeanNode(julian.J2000+365.25))
+	if !mathutils.AlmostEqual(got, 19.3414, 1e-3) {
+		t.Errorf("Expected: %f, got: %f", 19.3414, got)
+	}
+}
+
+func TestTrueNode(t *testing.T) {
+	for jd := _JDE; jd < _JDE+365; jd += 10 {
+		diff := math.Remainder(TrueNode(jd)-MeanNode(jd), 360)
+		if math.Abs(diff) > 2 {
+			t.Errorf("True node differs from the mean one by %f", diff)
+		}
+	}
+}
+
+func TestMeanPerigee(t *testing.T) {
+	if got := MeanPerigee(julian.J2000); !mathutils.AlmostEqual(got, 83.3532465, 1e-7) {
+		t.Errorf("Expected: %f, got: %f", 83.3532465, got)
+	}
+}
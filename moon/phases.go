@@ -0,0 +1,245 @@
+package moon
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/deltat"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// Mean length of the synodic month, days
+const SYNODIC_MONTH = 29.530588861
+
+// Principal phases of the Moon.
+type Phase int
+
+const (
+	NewMoon Phase = iota
+	FirstQuarter
+	FullMoon
+	LastQuarter
+)
+
+// Lunation numbering systems.
+type LunationSystem int
+
+const (
+	// Lunation 0 began with the New Moon of 2000 January 6 (J.Meeus).
+	MeeusLunation LunationSystem = iota
+	// Lunation 1 began with the New Moon of 1923 January 17 (E.W.Brown).
+	BrownLunation
+	// Lunation 1 began in July 622 AD, the start of the Islamic calendar.
+	IslamicLunation
+)
+
+// Lunation numbers offsets relative to the Meeus system.
+var _LUNATION_OFFSETS = map[LunationSystem]int{
+	MeeusLunation:   0,
+	BrownLunation:   953,
+	IslamicLunation: 17038,
+}
+
+// Instant of a lunar phase.
+type PhaseEvent struct {
+	// the phase
+	Phase Phase
+	// Julian Date, UT
+	JD float64
+	// Meeus lunation number
+	Lunation int
+}
+
+// Additional corrections for all the phases, amplitudes in days.
+var _ADDITIONAL = [...][3]float64{
+	// A0, A1, amplitude
+	{299.77, 0.107408, 0.000325},
+	{251.88, 0.016321, 0.000165},
+	{251.83, 26.651886, 0.000164},
+	{349.42, 36.412478, 0.000126},
+	{84.66, 18.206239, 0.000110},
+	{141.74, 53.303771, 0.000062},
+	{207.14, 2.453732, 0.000060},
+	{154.84, 7.306860, 0.000056},
+	{34.52, 27.261239, 0.000047},
+	{207.19, 0.121824, 0.000042},
+	{291.34, 1.844379, 0.000040},
+	{161.72, 24.198154, 0.000037},
+	{239.56, 25.513099, 0.000035},
+	{331.55, 3.592518, 0.000023},
+}
+
+// Given [k], lunation number with fractional part 0 (New Moon), 0.25 (First
+// Quarter), 0.5 (Full Moon) or 0.75 (Last Quarter), calculate Julian
+// Ephemeris Date of the phase.
+func phaseJDE(k float64, phase Phase) float64 {
+	t := k / 1236.85
+	t2 := t * t
+	t3 := t2 * t
+	t4 := t3 * t
+	jde := 2451550.09766 + SYNODIC_MONTH*k + 0.00015437*t2 - 0.000000150*t3 + 0.00000000073*t4
+
+	e := 1 - 0.002516*t - 0.0000074*t2
+	m := mathutils.Radians(2.5534 + 29.10535670*k - 0.0000014*t2 - 0.00000011*t3)
+	mp := mathutils.Radians(201.5643 + 385.81693528*k + 0.0107582*t2 + 0.00001238*t3 - 0.000000058*t4)
+	f := mathutils.Radians(160.7108 + 390.67050284*k - 0.0016118*t2 - 0.00000227*t3 + 0.000000011*t4)
+	om := mathutils.Radians(124.7746 - 1.56375588*k + 0.0020672*t2 + 0.00000215*t3)
+
+	var corr float64
+	switch phase {
+	case NewMoon:
+		corr = -0.40720*math.Sin(mp) +
+			0.17241*e*math.Sin(m) +
+			0.01608*math.Sin(2*mp) +
+			0.01039*math.Sin(2*f) +
+			0.00739*e*math.Sin(mp-m) -
+			0.00514*e*math.Sin(mp+m) +
+			0.00208*e*e*math.Sin(2*m) -
+			0.00111*math.Sin(mp-2*f) -
+			0.00057*math.Sin(mp+2*f) +
+			0.00056*e*math.Sin(2*mp+m) -
+			0.00042*math.Sin(3*mp) +
+			0.00042*e*math.Sin(m+2*f) +
+			0.00038*e*math.Sin(m-2*f) -
+			0.00024*e*math.Sin(2*mp-m) -
+			0.00017*math.Sin(om) -
+			0.00007*math.Sin(mp+2*m) +
+			0.00004*math.Sin(2*mp-2*f) +
+			0.00004*math.Sin(3*m) +
+			0.00003*math.Sin(mp+m-2*f) +
+			0.00003*math.Sin(2*mp+2*f) -
+			0.00003*math.Sin(mp+m+2*f) +
+			0.00003*math.Sin(mp-m+2*f) -
+			0.00002*math.Sin(mp-m-2*f) -
+			0.00002*math.Sin(3*mp+m) +
+			0.00002*math.Sin(4*mp)
+	case FullMoon:
+		corr = -0.40614*math.Sin(mp) +
+			0.17302*e*math.Sin(m) +
+			0.01614*math.Sin(2*mp) +
+			0.01043*math.Sin(2*f) +
+			0.00734*e*math.Sin(mp-m) -
+			0.00515*e*math.Sin(mp+m) +
+			0.00209*e*e*math.Sin(2*m) -
+			0.00111*math.Sin(mp-2*f) -
+			0.00057*math.Sin(mp+2*f) +
+			0.00056*e*math.Sin(2*mp+m) -
+			0.00042*math.Sin(3*mp) +
+			0.00042*e*math.Sin(m+2*f) +
+			0.00038*e*math.Sin(m-2*f) -
+			0.00024*e*math.Sin(2*mp-m) -
+			0.00017*math.Sin(om) -
+			0.00007*math.Sin(mp+2*m) +
+			0.00004*math.Sin(2*mp-2*f) +
+			0.00004*math.Sin(3*m) +
+			0.00003*math.Sin(mp+m-2*f) +
+			0.00003*math.Sin(2*mp+2*f) -
+			0.00003*math.Sin(mp+m+2*f) +
+			0.00003*math.Sin(mp-m+2*f) -
+			0.00002*math.Sin(mp-m-2*f) -
+			0.00002*math.Sin(3*mp+m) +
+			0.00002*math.Sin(4*mp)
+	default:
+		corr = -0.62801*math.Sin(mp) +
+			0.17172*e*math.Sin(m) -
+			0.01183*e*math.Sin(mp+m) +
+			0.00862*math.Sin(2*mp) +
+			0.00804*math.Sin(2*f) +
+			0.00454*e*math.Sin(mp-m) +
+			0.00204*e*e*math.Sin(2*m) -
+			0.00180*math.Sin(mp-2*f) -
+			0.00070*math.Sin(mp+2*f) -
+			0.00040*math.Sin(3*mp) -
+			0.00034*e*math.Sin(2*mp-m) +
+			0.00032*e*math.Sin(m+2*f) +
+			0.00032*e*math.Sin(m-2*f) -
+			0.00028*e*e*math.Sin(mp+2*m) +
+			0.00027*e*math.Sin(2*mp+m) -
+			0.00017*math.Sin(om) -
+			0.00005*math.Sin(mp-m-2*f) +
+			0.00004*math.Sin(2*mp+2*f) -
+			0.00004*math.Sin(mp+m+2*f) +
+			0.00004*math.Sin(mp-2*m) +
+			0.00003*math.Sin(mp+m-2*f) +
+			0.00003*math.Sin(3*m) +
+			0.00002*math.Sin(2*mp-2*f) +
+			0.00002*math.Sin(mp-m+2*f) -
+			0.00002*math.Sin(3*mp+m)
+		w := 0.00306 -
+			0.00038*e*math.Cos(m) +
+			0.00026*math.Cos(mp) -
+			0.00002*math.Cos(mp-m) +
+			0.00002*math.Cos(mp+m) +
+			0.00002*math.Cos(2*f)
+		if phase == FirstQuarter {
+			corr += w
+		} else {
+			corr -= w
+		}
+	}
+
+	for i, a := range _ADDITIONAL {
+		arg := a[0] + a[1]*k
+		if i == 0 {
+			arg -= 0.009173 * t2
+		}
+		corr += a[2] * math.Sin(mathutils.Radians(arg))
+	}
+	return jde + corr
+}
+
+// Converts Julian Ephemeris Date to UT.
+func universal(jde float64) float64 {
+	return jde - deltat.DeltaT(jde)/julian.SEC_PER_DAY
+}
+
+// Given integer lunation number [n] in Meeus system and a [phase],
+// calculate UT Julian Date of the phase.
+func LunationPhase(n int, phase Phase) float64 {
+	return universal(phaseJDE(float64(n)+float64(phase)/4, phase))
+}
+
+// Given [jd], UT Julian Date and a [phase], find the instant of the phase
+// nearest to the date.
+func NearestPhase(jd float64, phase Phase) PhaseEvent {
+	n := int(math.Round((jd-2451550.09766)/SYNODIC_MONTH - float64(phase)/4))
+	best := PhaseEvent{Phase: phase, JD: LunationPhase(n, phase), Lunation: n}
+	for _, i := range [...]int{n - 1, n + 1} {
+		t := LunationPhase(i, phase)
+		if math.Abs(t-jd) < math.Abs(best.JD-jd) {
+			best = PhaseEvent{Phase: phase, JD: t, Lunation: i}
+		}
+	}
+	return best
+}
+
+// Given [start] and [end], UT Julian Dates, find all principal phases
+// within the range in chronological order.
+func PhasesInRange(start, end float64) []PhaseEvent {
+	var res []PhaseEvent
+	n := int(math.Floor((start-2451550.09766)/SYNODIC_MONTH)) - 1
+	for ; ; n++ {
+		for p := NewMoon; p <= LastQuarter; p++ {
+			t := LunationPhase(n, p)
+			if t >= end {
+				return res
+			}
+			if t >= start {
+				res = append(res, PhaseEvent{Phase: p, JD: t, Lunation: n})
+			}
+		}
+	}
+}
+
+// Given [jd], UT Julian Date, find the number of lunation, which began with
+// the New Moon preceding the date, in a given numbering [system].
+func Lunation(jd float64, system LunationSystem) int {
+	n := int(math.Floor((jd - 2451550.09766) / SYNODIC_MONTH))
+	for LunationPhase(n, NewMoon) > jd {
+		n--
+	}
+	for LunationPhase(n+1, NewMoon) <= jd {
+		n++
+	}
+	return n + _LUNATION_OFFSETS[system]
+}
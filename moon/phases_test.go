@@ -0,0 +1,73 @@
+package moon
+
+import (
+	"testing"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+func TestPhaseJDE(t *testing.T) {
+	// J.Meeus, "Astronomical Algorithms", examples 49.a and 49.b
+	if got := phaseJDE(-283, NewMoon); !mathutils.AlmostEqual(got, 2443192.65118, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", 2443192.65118, got)
+	}
+	if got := phaseJDE(544.75, LastQuarter); !mathutils.AlmostEqual(got, 2467636.49186, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", 2467636.49186, got)
+	}
+}
+
+func TestNearestPhase(t *testing.T) {
+	jd := julian.CivilToJulian(julian.CivilDate{Year: 2024, Month: 1, Day: 20})
+	cases := map[Phase]string{
+		NewMoon:      "2024-01-11T11:56:52Z",
+		FirstQuarter: "2024-01-18T03:52:06Z",
+		FullMoon:     "2024-01-25T17:53:30Z",
+		LastQuarter:  "2024-02-02T23:17:31Z",
+	}
+	for phase, exp := range cases {
+		ev := NearestPhase(jd, phase)
+		if ev.Phase != phase {
+			t.Errorf("Expected phase: %d, got: %d", phase, ev.Phase)
+		}
+		if got := julian.JulianToDateString(ev.JD); got != exp {
+			t.Errorf("Expected: %s, got: %s", exp, got)
+		}
+	}
+}
+
+func TestPhasesInRange(t *testing.T) {
+	start := julian.CivilToJulian(julian.CivilDate{Year: 2024, Month: 1, Day: 1})
+	end := julian.CivilToJulian(julian.CivilDate{Year: 2025, Month: 1, Day: 1})
+	events := PhasesInRange(start, end)
+	if len(events) != 50 {
+		t.Errorf("Expected 50 phases, got: %d", len(events))
+	}
+	if events[0].Phase != LastQuarter || events[0].Lunation != 296 {
+		t.Errorf("Expected last quarter of lunation 296, got: %v", events[0])
+	}
+	for i := 1; i < len(events); i++ {
+		if events[i].JD <= events[i-1].JD || events[i].Phase != (events[i-1].Phase+1)%4 {
+			t.Errorf("Wrong sequence of phases: %v, %v", events[i-1], events[i])
+		}
+	}
+}
+
+func TestLunation(t *testing.T) {
+	jd := julian.CivilToJulian(julian.CivilDate{Year: 2000, Month: 1, Day: 10})
+	cases := map[LunationSystem]int{
+		MeeusLunation:   0,
+		BrownLunation:   953,
+		IslamicLunation: 17038,
+	}
+	for system, exp := range cases {
+		if got := Lunation(jd, system); got != exp {
+			t.Errorf("Expected: %d, got: %d", exp, got)
+		}
+	}
+	// Brown lunation 1 began on 1923 January 17
+	jd = julian.CivilToJulian(julian.CivilDate{Year: 1923, Month: 1, Day: 17.5})
+	if got := Lunation(jd, BrownLunation); got != 1 {
+		t.Errorf("Expected: %d, got: %d", 1, got)
+	}
+}
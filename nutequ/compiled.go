@@ -0,0 +1,42 @@
+package nutequ
+
+import "github.com/skrushinsky/scaliger/mathutils"
+
+// Length of a Chebyshev segment, days; the shortest period of the series
+// is about 9 days.
+const _SEGMENT = 4.0
+
+// Degree of Chebyshev polynomials.
+const _DEGREE = 12
+
+// Given interval between [start] and [end] Julian Dates, compile Nutation
+// into piecewise Chebyshev series, which are evaluated much faster than
+// the trigonometric series, with difference less than 1e-9 arcseconds.
+// Outside the interval the returned function falls back to Nutation.
+//
+// The result fits Nutation field of sidereal.SiderealOptions:
+//
+//	nut, _ := CompileNutation(2451544.5, 2462502.5)
+//	opts := sidereal.SiderealOptions{Kind: sidereal.KindAutoApparent, Nutation: nut}
+func CompileNutation(start, end float64) (func(jd float64) (dpsi float64, deps float64), error) {
+	psi, err := mathutils.CompileChebyshev(func(jd float64) float64 {
+		dpsi, _ := Nutation(jd)
+		return dpsi
+	}, start, end, _SEGMENT, _DEGREE)
+	if err != nil {
+		return nil, err
+	}
+	eps, err := mathutils.CompileChebyshev(func(jd float64) float64 {
+		_, deps := Nutation(jd)
+		return deps
+	}, start, end, _SEGMENT, _DEGREE)
+	if err != nil {
+		return nil, err
+	}
+	return func(jd float64) (float64, float64) {
+		if !psi.Contains(jd) {
+			return Nutation(jd)
+		}
+		return psi.Eval(jd), eps.Eval(jd)
+	}, nil
+}
@@ -0,0 +1,40 @@
+package nutequ
+
+import (
+	"testing"
+
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+func TestCompileNutation(t *testing.T) {
+	start, end := 2451544.5, 2451544.5+3652.5
+	nut, err := CompileNutation(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// inside the interval and outside of it
+	for jd := start - 100; jd < end+100; jd += 0.713 {
+		expPsi, expEps := Nutation(jd)
+		gotPsi, gotEps := nut(jd)
+		if !mathutils.AlmostEqual(gotPsi, expPsi, 1e-9/3600) {
+			t.Fatalf("JD %f. Expected: %.12f, got: %.12f", jd, expPsi, gotPsi)
+		}
+		if !mathutils.AlmostEqual(gotEps, expEps, 1e-9/3600) {
+			t.Fatalf("JD %f. Expected: %.12f, got: %.12f", jd, expEps, gotEps)
+		}
+	}
+}
+
+func BenchmarkNutation(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Nutation(2451544.5 + float64(i%3650))
+	}
+}
+
+func BenchmarkCompiledNutation(b *testing.B) {
+	nut, _ := CompileNutation(2451544.5, 2451544.5+3652.5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		nut(2451544.5 + float64(i%3650))
+	}
+}
@@ -0,0 +1,128 @@
+// Observer's site on the Earth surface: geodetic coordinates, reference
+// ellipsoid and time zone, with conversion to geocentric coordinates and
+// topocentric corrections.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, chapters 11, 40.
+package observer
+
+import (
+	"math"
+	"time"
+
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/sidereal"
+)
+
+// Astronomical unit, meters.
+const AU = 149597870700.0
+
+// Angular velocity of the Earth rotation, radians per second.
+const EARTH_ROTATION = 7.292115e-5
+
+// Reference ellipsoid.
+type Ellipsoid struct {
+	// equatorial radius, meters
+	A float64
+	// flattening
+	F float64
+}
+
+var (
+	WGS84   = Ellipsoid{A: 6378137, F: 1 / 298.257223563}
+	GRS80   = Ellipsoid{A: 6378137, F: 1 / 298.257222101}
+	IAU1976 = Ellipsoid{A: 6378140, F: 1 / 298.257}
+)
+
+// Geographical position of the observer.
+type Observer struct {
+	// geographical longitude, arc-degrees, negative westwards
+	Lng float64
+	// geodetic latitude, arc-degrees, negative southwards
+	Lat float64
+	// height above sea level, meters
+	Height float64
+	// time zone, UTC if nil
+	Location *time.Location
+	// reference ellipsoid, WGS84 if zero
+	Ellipsoid Ellipsoid
+}
+
+func (o Observer) ellipsoid() Ellipsoid {
+	if o.Ellipsoid.A == 0 {
+		return WGS84
+	}
+	return o.Ellipsoid
+}
+
+// Time zone of the observer, UTC by default.
+func (o Observer) Zone() *time.Location {
+	if o.Location == nil {
+		return time.UTC
+	}
+	return o.Location
+}
+
+// Options for sidereal.JulianToSidereal: observer's longitude and apparent
+// sidereal time, calculated with the default nutation and Delta-T models.
+func (o Observer) SiderealOptions() sidereal.SiderealOptions {
+	return sidereal.SiderealOptions{Lng: o.Lng, Kind: sidereal.KindAutoApparent}
+}
+
+// Calculates ρ·sin φ′ and ρ·cos φ′, where ρ is observer's distance from
+// the center of the Earth in equatorial radii and φ′ is geocentric
+// latitude.
+func (o Observer) RhoPhi() (rhoSin float64, rhoCos float64) {
+	e := o.ellipsoid()
+	lat := mathutils.Radians(o.Lat)
+	u := math.Atan((1 - e.F) * math.Tan(lat))
+	h := o.Height / e.A
+	rhoSin = (1-e.F)*math.Sin(u) + h*math.Sin(lat)
+	rhoCos = math.Cos(u) + h*math.Cos(lat)
+	return
+}
+
+// Geocentric latitude φ′, arc-degrees.
+func (o Observer) GeocentricLatitude() float64 {
+	rs, rc := o.RhoPhi()
+	return mathutils.Degrees(math.Atan2(rs, rc))
+}
+
+// Distance from the center of the Earth, meters.
+func (o Observer) Distance() float64 {
+	rs, rc := o.RhoPhi()
+	return math.Hypot(rs, rc) * o.ellipsoid().A
+}
+
+// Given [lst], local sidereal time in hours, calculate geocentric
+// equatorial rectangular coordinates of the observer, meters.
+func (o Observer) Position(lst float64) coords.Cartesian {
+	rs, rc := o.RhoPhi()
+	a := o.ellipsoid().A
+	st, ct := math.Sincos(mathutils.Radians(lst * 15))
+	return coords.Cartesian{X: a * rc * ct, Y: a * rc * st, Z: a * rs}
+}
+
+// Given [lst], local sidereal time in hours, calculate velocity of the
+// observer due to the Earth rotation, meters per second, in the same
+// frame as Position.
+func (o Observer) Velocity(lst float64) coords.Cartesian {
+	p := o.Position(lst)
+	return coords.Cartesian{X: -EARTH_ROTATION * p.Y, Y: EARTH_ROTATION * p.X, Z: 0}
+}
+
+// Given [ra], [dec], geocentric equatorial coordinates of a body,
+// [dist], its distance from the center of the Earth in AU and [lst],
+// local sidereal time in hours, calculate topocentric right ascension and
+// declination, arc-degrees, corrected for parallax.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, formulae 40.2, 40.3.
+func (o Observer) Topocentric(ra, dec, dist, lst float64) (float64, float64) {
+	rs, rc := o.RhoPhi()
+	sp := o.ellipsoid().A / (dist * AU)
+	sh, ch := math.Sincos(mathutils.Radians(coords.HourAngleDeg(lst, ra)))
+	sd, cd := math.Sincos(mathutils.Radians(dec))
+	da := math.Atan2(-rc*sp*sh, cd-rc*sp*ch)
+	dec1 := math.Atan2((sd-rs*sp)*math.Cos(da), cd-rc*sp*ch)
+	return mathutils.ReduceDeg(ra + mathutils.Degrees(da)), mathutils.Degrees(dec1)
+}
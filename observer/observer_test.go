@@ -0,0 +1,77 @@
+package observer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// Palomar Observatory, J.Meeus, "Astronomical Algorithms", examples 11.a, 40.a
+var palomar = Observer{
+	Lng:       -mathutils.AngleFromHMS(false, 7, 47, 27).Degrees(),
+	Lat:       mathutils.AngleFromDMS(false, 33, 21, 22).Degrees(),
+	Height:    1706,
+	Ellipsoid: IAU1976,
+}
+
+func TestRhoPhi(t *testing.T) {
+	rs, rc := palomar.RhoPhi()
+	if !mathutils.AlmostEqual(rs, 0.546861, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 0.546861, rs)
+	}
+	if !mathutils.AlmostEqual(rc, 0.836339, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 0.836339, rc)
+	}
+}
+
+func TestGeocentricLatitude(t *testing.T) {
+	// φ − φ′ reaches 11.5′ at latitude 45°
+	obs := Observer{Lat: 45}
+	got := (obs.Lat - obs.GeocentricLatitude()) * 60
+	if !mathutils.AlmostEqual(got, 11.54, 0.01) {
+		t.Errorf("Expected: %f, got: %f", 11.54, got)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	pole := Observer{Lat: 90}
+	if got := pole.Distance(); !mathutils.AlmostEqual(got, 6356752.3, 0.1) {
+		t.Errorf("Expected: %f, got: %f", 6356752.3, got)
+	}
+	equator := Observer{Ellipsoid: GRS80, Height: 100}
+	if got := equator.Distance(); !mathutils.AlmostEqual(got, 6378237, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 6378237.0, got)
+	}
+}
+
+func TestVelocity(t *testing.T) {
+	v := Observer{}.Velocity(6)
+	// 465 m/s eastwards, towards the point with sidereal time 12h
+	if !mathutils.AlmostEqual(v.X, -465.1, 0.1) || !mathutils.AlmostEqual(v.Y, 0, 1e-9) {
+		t.Errorf("Expected: %f, %f, got: %f, %f", -465.1, 0.0, v.X, v.Y)
+	}
+}
+
+func TestTopocentric(t *testing.T) {
+	// Mars, 2003 Aug 28 3:17 UT, Greenwich apparent sidereal time 1h40m45s
+	lst := mathutils.AngleFromHMS(false, 1, 40, 45).Hours() + palomar.Lng/15
+	ra, dec := palomar.Topocentric(339.530208, -15.771083, 0.37276, lst)
+	// 22h38m08.54s, -15°46'30.0"
+	if !mathutils.AlmostEqual(ra, 339.535583, 5e-5) {
+		t.Errorf("Expected: %f, got: %f", 339.535583, ra)
+	}
+	if !mathutils.AlmostEqual(dec, -15.775, 5e-5) {
+		t.Errorf("Expected: %f, got: %f", -15.775, dec)
+	}
+}
+
+func TestZone(t *testing.T) {
+	if got := (Observer{}).Zone(); got != time.UTC {
+		t.Errorf("Expected: %v, got: %v", time.UTC, got)
+	}
+	loc := time.FixedZone("MSK", 3*3600)
+	if got := (Observer{Location: loc}).Zone(); got != loc {
+		t.Errorf("Expected: %v, got: %v", loc, got)
+	}
+}
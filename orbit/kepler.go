@@ -0,0 +1,62 @@
+package orbit
+
+import "math"
+
+// Maximal number of iterations.
+const _MAX_ITER = 100
+
+// Required precision, radians.
+const _PRECISION = 1e-15
+
+// Given [m], mean anomaly, and [e], eccentricity, 0 <= e < 1, solve
+// Kepler's equation for an elliptic orbit:
+//
+//	M = E - e·sin(E)
+//
+// Both anomalies are in radians; the result has the same number of
+// revolutions as m. The starting value is robust for eccentricities close
+// to 1, so the Newton's iterations always converge.
+//
+// Source: J.M.A.Danby, "Fundamentals of Celestial Mechanics", 2d edition.
+func EccentricAnomaly(m, e float64) float64 {
+	// reduce to -pi..pi
+	m0 := math.Remainder(m, 2*math.Pi)
+	ea := m0 + 0.85*e*math.Copysign(1, math.Sin(m0))
+	for i := 0; i < _MAX_ITER; i++ {
+		se, ce := math.Sincos(ea)
+		de := (ea - e*se - m0) / (1 - e*ce)
+		ea -= de
+		if math.Abs(de) < _PRECISION {
+			break
+		}
+	}
+	return ea + (m - m0)
+}
+
+// Given [m], mean anomaly in radians, and [e], eccentricity, e > 1, solve
+// Kepler's equation for a hyperbolic orbit:
+//
+//	M = e·sinh(H) - H
+func HyperbolicAnomaly(m, e float64) float64 {
+	h := math.Copysign(math.Log(2*math.Abs(m)/e+1.8), m)
+	for i := 0; i < _MAX_ITER; i++ {
+		dh := (e*math.Sinh(h) - h - m) / (e*math.Cosh(h) - 1)
+		h -= dh
+		if math.Abs(dh) < _PRECISION*math.Max(1, math.Abs(h)) {
+			break
+		}
+	}
+	return h
+}
+
+// Given [w] = 3k/√2·(t - T)/q^1.5, solve Barker's equation for a parabolic
+// orbit:
+//
+//	W = 3s + s³, s = tan(v/2)
+//
+// and return true anomaly v in radians.
+func ParabolicAnomaly(w float64) float64 {
+	y := math.Cbrt(w/2 + math.Sqrt(w*w/4+1))
+	s := y - 1/y
+	return 2 * math.Atan(s)
+}
@@ -0,0 +1,242 @@
+// Two-body motion: Kepler's equation, heliocentric positions of comets and
+// asteroids from osculating orbital elements, conversion between elements
+// and state vectors.
+//
+// Elements refer to the ecliptic and the equinox of the Equinox field,
+// J2000 by default. Positions are heliocentric rectangular coordinates in
+// AU, velocities are in AU per day. Time arguments are Julian Ephemeris
+// Dates (JDE), see deltat package.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, chapters 30, 33-35.
+package orbit
+
+import (
+	"errors"
+	"math"
+
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/nutequ"
+)
+
+// Gaussian gravitational constant, k² is the Sun's gravitational parameter
+// in AU³/day².
+const GAUSS_K = 0.01720209895
+
+const _MU = GAUSS_K * GAUSS_K
+
+// Osculating orbital elements.
+type Elements struct {
+	// perihelion distance, AU
+	Q float64
+	// eccentricity: 0 <= e < 1 for ellipse, 1 for parabola, > 1 for hyperbola
+	E float64
+	// inclination, arc-degrees
+	I float64
+	// longitude of the ascending node, arc-degrees
+	Node float64
+	// argument of perihelion, arc-degrees
+	Peri float64
+	// time of perihelion passage, JDE
+	T float64
+	// equinox of the elements, JDE; zero means J2000
+	Equinox float64
+}
+
+// Position and velocity.
+type StateVector struct {
+	// AU
+	Position coords.Cartesian
+	// AU per day
+	Velocity coords.Cartesian
+}
+
+// Given elements of an elliptic orbit, typical for asteroids: [a],
+// semi-major axis in AU, [e], eccentricity, [i], [node], [peri], angles in
+// arc-degrees, [m], mean anomaly in arc-degrees at [epoch], JDE, build
+// Elements referred to J2000.
+func FromMeanAnomaly(a, e, i, node, peri, m, epoch float64) (Elements, error) {
+	if a <= 0 || e < 0 || e >= 1 {
+		return Elements{}, errors.New("orbit: elliptic orbit expected")
+	}
+	n := mathutils.Degrees(GAUSS_K / math.Pow(a, 1.5))
+	return Elements{
+		Q:    a * (1 - e),
+		E:    e,
+		I:    i,
+		Node: node,
+		Peri: peri,
+		T:    epoch - math.Remainder(m, 360)/n,
+	}, nil
+}
+
+// Semi-major axis in AU, negative for hyperbola, infinite for parabola.
+func (el Elements) SemiMajorAxis() float64 {
+	return el.Q / (1 - el.E)
+}
+
+// Mean motion in arc-degrees per day, infinite for parabola.
+func (el Elements) MeanMotion() float64 {
+	return mathutils.Degrees(GAUSS_K / math.Pow(math.Abs(el.SemiMajorAxis()), 1.5))
+}
+
+// Orbital period in days, infinite for parabola and hyperbola.
+func (el Elements) Period() float64 {
+	if el.E >= 1 {
+		return math.Inf(1)
+	}
+	return 360 / el.MeanMotion()
+}
+
+func (el Elements) equinox() float64 {
+	if el.Equinox == 0 {
+		return julian.J2000
+	}
+	return el.Equinox
+}
+
+// Given [jde], calculate true anomaly [v] in arc-degrees and radius vector
+// [r] in AU.
+func (el Elements) Anomaly(jde float64) (v float64, r float64) {
+	dt := jde - el.T
+	switch {
+	case el.E < 1:
+		a := el.SemiMajorAxis()
+		m := GAUSS_K / math.Pow(a, 1.5) * dt
+		ea := EccentricAnomaly(m, el.E)
+		v = 2 * math.Atan(math.Sqrt((1+el.E)/(1-el.E))*math.Tan(ea/2))
+		r = a * (1 - el.E*math.Cos(ea))
+	case el.E > 1:
+		a := -el.SemiMajorAxis()
+		m := GAUSS_K / math.Pow(a, 1.5) * dt
+		h := HyperbolicAnomaly(m, el.E)
+		v = 2 * math.Atan(math.Sqrt((el.E+1)/(el.E-1))*math.Tanh(h/2))
+		r = a * (el.E*math.Cosh(h) - 1)
+	default:
+		w := 3 * GAUSS_K / math.Sqrt2 * dt / math.Pow(el.Q, 1.5)
+		v = ParabolicAnomaly(w)
+		s := math.Tan(v / 2)
+		r = el.Q * (1 + s*s)
+	}
+	return mathutils.Degrees(v), r
+}
+
+// Matrix converting coordinates in the orbital plane, with X axis pointing
+// to perihelion, to ecliptic ones.
+func (el Elements) orbitalToEcliptic() coords.Matrix {
+	return coords.RotationZ(-el.Node).Mul(coords.RotationX(-el.I)).Mul(coords.RotationZ(-el.Peri))
+}
+
+// Given [jde], calculate heliocentric ecliptic state vector.
+func (el Elements) State(jde float64) StateVector {
+	v, r := el.Anomaly(jde)
+	sv, cv := math.Sincos(mathutils.Radians(v))
+	// speed factor, sqrt(mu / p)
+	k := math.Sqrt(_MU / (el.Q * (1 + el.E)))
+	m := el.orbitalToEcliptic()
+	return StateVector{
+		Position: m.Apply(coords.Cartesian{X: r * cv, Y: r * sv}),
+		Velocity: m.Apply(coords.Cartesian{X: -k * sv, Y: k * (el.E + cv)}),
+	}
+}
+
+// Given [jde], calculate heliocentric ecliptic rectangular coordinates.
+func (el Elements) Ecliptic(jde float64) coords.Cartesian {
+	return el.State(jde).Position
+}
+
+// Given [jde], calculate heliocentric equatorial rectangular coordinates,
+// referred to the equinox of the elements. The obliquity of the ecliptic is
+// given by nutequ.MeanObliquity.
+func (el Elements) Equatorial(jde float64) coords.Cartesian {
+	eps := nutequ.MeanObliquity(el.equinox())
+	return coords.EclipticToEquatorialMatrix(eps).Apply(el.Ecliptic(jde))
+}
+
+func cross(a, b coords.Cartesian) coords.Cartesian {
+	return coords.Cartesian{
+		X: a.Y*b.Z - a.Z*b.Y,
+		Y: a.Z*b.X - a.X*b.Z,
+		Z: a.X*b.Y - a.Y*b.X,
+	}
+}
+
+func dot(a, b coords.Cartesian) float64 {
+	return a.X*b.X + a.Y*b.Y + a.Z*b.Z
+}
+
+func norm(a coords.Cartesian) float64 {
+	return math.Sqrt(dot(a, a))
+}
+
+// Given [sv], heliocentric ecliptic state vector at [jde], calculate
+// osculating elements referred to [equinox], JDE, (zero for J2000).
+//
+// For elliptic orbits the perihelion passage nearest to jde is returned.
+// For orbits in the plane of the ecliptic the node is 0; for circular
+// orbits the argument of perihelion is 0 and the perihelion time is the
+// moment of passing the node.
+func ElementsFromState(sv StateVector, jde, equinox float64) (Elements, error) {
+	r, v := sv.Position, sv.Velocity
+	rn := norm(r)
+	h := cross(r, v)
+	hn := norm(h)
+	if rn == 0 || hn == 0 {
+		return Elements{}, errors.New("orbit: degenerate state vector")
+	}
+	// eccentricity vector
+	rv := dot(r, v)
+	v2 := dot(v, v)
+	ev := coords.Cartesian{
+		X: ((v2-_MU/rn)*r.X - rv*v.X) / _MU,
+		Y: ((v2-_MU/rn)*r.Y - rv*v.Y) / _MU,
+		Z: ((v2-_MU/rn)*r.Z - rv*v.Z) / _MU,
+	}
+	e := norm(ev)
+	p := hn * hn / _MU
+	el := Elements{E: e, Q: p / (1 + e), Equinox: equinox}
+	el.I = mathutils.Degrees(math.Acos(h.Z / hn))
+
+	// node vector
+	nv := coords.Cartesian{X: -h.Y, Y: h.X}
+	nn := norm(nv)
+	if nn > 1e-12*hn {
+		el.Node = mathutils.ReduceDeg(mathutils.Degrees(math.Atan2(nv.Y, nv.X)))
+	} else {
+		nv, nn = coords.Cartesian{X: 1}, 1
+	}
+	// unit vector perpendicular to the node line in the orbital plane
+	w := cross(h, nv)
+	wn := norm(w)
+	// argument of latitude of the perihelion and of the body
+	arg := func(c coords.Cartesian) float64 {
+		return math.Atan2(dot(c, w)/wn, dot(c, nv)/nn)
+	}
+	u := arg(r)
+	peri := 0.0
+	if e > 1e-12 {
+		peri = arg(ev)
+	}
+	el.Peri = mathutils.ReduceDeg(mathutils.Degrees(peri))
+	ta := u - peri // true anomaly
+
+	// time since perihelion
+	var dt float64
+	switch {
+	case math.Abs(e-1) < 1e-12:
+		el.E = 1
+		s := math.Tan(ta / 2)
+		dt = math.Sqrt2 * math.Pow(el.Q, 1.5) / (3 * GAUSS_K) * (s*s*s + 3*s)
+	case e < 1:
+		a := el.Q / (1 - e)
+		ea := 2 * math.Atan(math.Sqrt((1-e)/(1+e))*math.Tan(ta/2))
+		dt = (ea - e*math.Sin(ea)) / (GAUSS_K / math.Pow(a, 1.5))
+	default:
+		a := el.Q / (e - 1)
+		ha := 2 * math.Atanh(math.Sqrt((e-1)/(e+1))*math.Tan(ta/2))
+		dt = (e*math.Sinh(ha) - ha) / (GAUSS_K / math.Pow(a, 1.5))
+	}
+	el.T = jde - dt
+	return el, nil
+}
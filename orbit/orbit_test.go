@@ -0,0 +1,125 @@
+package orbit
+
+import (
+	"math"
+	"testing"
+
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/vsop87"
+)
+
+func TestEccentricAnomaly(t *testing.T) {
+	// Meeus, example 30.a
+	got := mathutils.Degrees(EccentricAnomaly(mathutils.Radians(5), 0.1))
+	if !mathutils.AlmostEqual(got, 5.554589, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 5.554589, got)
+	}
+}
+
+func TestKeplerResiduals(t *testing.T) {
+	for _, e := range []float64{0, 0.3, 0.9, 0.99, 0.999999} {
+		for _, m := range []float64{-7, -1e-6, 0, 1e-4, 0.5, 3.1, 12} {
+			ea := EccentricAnomaly(m, e)
+			if r := ea - e*math.Sin(ea) - m; math.Abs(r) > 1e-12 {
+				t.Errorf("e = %f, M = %f: residual %e", e, m, r)
+			}
+		}
+	}
+	for _, e := range []float64{1.000001, 1.5, 5} {
+		for _, m := range []float64{-50, -0.001, 0, 0.3, 1000} {
+			h := HyperbolicAnomaly(m, e)
+			if r := e*math.Sinh(h) - h - m; math.Abs(r) > 1e-9*math.Max(1, math.Abs(m)) {
+				t.Errorf("e = %f, M = %f: residual %e", e, m, r)
+			}
+		}
+	}
+	for _, w := range []float64{-20, 0, 0.5, 300} {
+		s := math.Tan(ParabolicAnomaly(w) / 2)
+		if r := 3*s + s*s*s - w; math.Abs(r) > 1e-9*math.Max(1, math.Abs(w)) {
+			t.Errorf("W = %f: residual %e", w, r)
+		}
+	}
+}
+
+func TestEarthOrbit(t *testing.T) {
+	// mean elements of the Earth-Moon barycenter, J2000
+	varpi, l := 102.94719, 100.46435
+	el, err := FromMeanAnomaly(1.00000011, 0.01671022, 0, 0, varpi, l-varpi, julian.J2000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jde := julian.J2000 + 100
+	got := el.Ecliptic(jde).Spherical()
+	lon, _, r := vsop87.Earth.Heliocentric(jde)
+	// the difference is due to perturbations and the Moon
+	if math.Abs(math.Remainder(got.Lon-lon, 360)) > 0.02 {
+		t.Errorf("Expected: %f, got: %f", lon, got.Lon)
+	}
+	if !mathutils.AlmostEqual(got.R, r, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", r, got.R)
+	}
+}
+
+func TestEquatorial(t *testing.T) {
+	// a body in the ecliptic at longitude 90 has declination equal to obliquity
+	el := Elements{Q: 1, E: 0, I: 0, Node: 0, Peri: 90, T: julian.J2000}
+	got := el.Equatorial(julian.J2000).Spherical()
+	if !mathutils.AlmostEqual(got.Lon, 90, 1e-9) || !mathutils.AlmostEqual(got.Lat, 23.439281, 1e-6) {
+		t.Errorf("Expected: 90, 23.439281, got: %f, %f", got.Lon, got.Lat)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	cases := []Elements{
+		// comet Encke, Meeus example 33.a
+		{Q: 2.2091404 * (1 - 0.8502196), E: 0.8502196, I: 11.94524, Node: 334.75006, Peri: 186.23352, T: 2448193.04502},
+		// parabolic comet
+		{Q: 1.487469, E: 1, I: 104.21, Node: 321.11, Peri: 69.46, T: 2451000.5},
+		// hyperbolic orbit
+		{Q: 0.255912, E: 1.20113, I: 122.74, Node: 24.597, Peri: 241.81, T: 2458080.5},
+	}
+	jde := 2451545.0
+	for _, el := range cases {
+		sv := el.State(jde)
+		got, err := ElementsFromState(sv, jde, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, pair := range [][3]float64{
+			{el.Q, got.Q, 1e-9},
+			{el.E, got.E, 1e-9},
+			{el.I, got.I, 1e-7},
+			{el.Node, got.Node, 1e-7},
+			{el.Peri, got.Peri, 1e-7},
+			// for ellipse, the nearest perihelion passage; the period is infinite
+			// for parabola and hyperbola
+			{0, math.Remainder(el.T-got.T, el.Period()), 1e-5},
+		} {
+			if !mathutils.AlmostEqual(pair[0], pair[1], pair[2]) {
+				t.Errorf("Expected: %v, got: %v", el, got)
+				break
+			}
+		}
+	}
+}
+
+func TestVelocity(t *testing.T) {
+	// velocity should match numerical derivative of the position
+	el := Elements{Q: 0.5871, E: 0.96714, I: 162.2384, Node: 58.1540, Peri: 111.8466, T: 2446470.5}
+	jde := 2446470.5 + 30
+	h := 1e-3
+	p1, p2 := el.Ecliptic(jde-h), el.Ecliptic(jde+h)
+	exp := coords.Cartesian{X: (p2.X - p1.X) / (2 * h), Y: (p2.Y - p1.Y) / (2 * h), Z: (p2.Z - p1.Z) / (2 * h)}
+	got := el.State(jde).Velocity
+	if norm(coords.Cartesian{X: got.X - exp.X, Y: got.Y - exp.Y, Z: got.Z - exp.Z}) > 1e-8 {
+		t.Errorf("Expected: %v, got: %v", exp, got)
+	}
+}
+
+func TestFromMeanAnomalyErrors(t *testing.T) {
+	if _, err := FromMeanAnomaly(2, 1.1, 0, 0, 0, 0, julian.J2000); err == nil {
+		t.Error("Expected error for hyperbolic orbit")
+	}
+}
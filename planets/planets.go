@@ -0,0 +1,185 @@
+// Heliocentric, geocentric and apparent positions of the major planets,
+// based on truncated VSOP87D series from vsop87 package.
+//
+// Apparent positions are corrected for light-time, aberration and nutation
+// and referred to the true equator and equinox of the date.
+//
+// All functions accept Julian Ephemeris Date (JDE). To obtain it, correct UT
+// Julian Date with deltat.DeltaT:
+//
+//	jde := jd + deltat.DeltaT(jd)/86400
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, chapters 23, 32, 33.
+package planets
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/nutequ"
+	"github.com/skrushinsky/scaliger/vsop87"
+)
+
+// Light-time for unit distance, days.
+const LIGHT_TIME = 0.0057755183
+
+// Constant of aberration, arc-degrees.
+const ABERRATION = 20.49552 / 3600
+
+// The major planets, except the Earth.
+type Planet int
+
+const (
+	Mercury Planet = iota
+	Venus
+	Mars
+	Jupiter
+	Saturn
+	Uranus
+	Neptune
+)
+
+var _NAMES = [...]string{"Mercury", "Venus", "Mars", "Jupiter", "Saturn", "Uranus", "Neptune"}
+
+func (p Planet) String() string {
+	return _NAMES[p]
+}
+
+// All planets, in order of distance from the Sun.
+var Planets = [...]Planet{Mercury, Venus, Mars, Jupiter, Saturn, Uranus, Neptune}
+
+var _THEORIES = [...]*vsop87.Planet{
+	&vsop87.Mercury,
+	&vsop87.Venus,
+	&vsop87.Mars,
+	&vsop87.Jupiter,
+	&vsop87.Saturn,
+	&vsop87.Uranus,
+	&vsop87.Neptune,
+}
+
+// Geocentric ecliptic position of a planet.
+type Position struct {
+	// ecliptic longitude, arc-degrees
+	Lon float64
+	// ecliptic latitude, arc-degrees
+	Lat float64
+	// distance from the Earth, AU
+	Delta float64
+	// light-time, days
+	LightTime float64
+}
+
+// Set of planetary theories, truncated to a given precision.
+type Ephemeris struct {
+	earth   vsop87.Planet
+	planets [len(_THEORIES)]vsop87.Planet
+}
+
+// Given [precision], amplitude of the smallest term in radians or AU,
+// build ephemeris which uses only larger terms of the series; zero
+// precision means the complete embedded series. With precision 1e-6
+// geocentric positions are calculated about 1.5 times faster, see
+// BenchmarkEphemeris, while errors remain below an arcsecond.
+func NewEphemeris(precision float64) *Ephemeris {
+	eph := &Ephemeris{earth: vsop87.Earth.Truncate(precision)}
+	for i, th := range _THEORIES {
+		eph.planets[i] = th.Truncate(precision)
+	}
+	return eph
+}
+
+// Ephemeris with the complete embedded series, used by package functions.
+var Default = NewEphemeris(0)
+
+// Given [jde], calculate heliocentric ecliptic longitude and latitude of
+// a planet in arc-degrees and radius vector in AU, referred to the mean
+// equinox of the date.
+func (eph *Ephemeris) Heliocentric(planet Planet, jde float64) (l float64, b float64, r float64) {
+	return eph.planets[planet].Heliocentric(jde)
+}
+
+func rectangular(th *vsop87.Planet, jde float64) coords.Cartesian {
+	l, b, r := th.Heliocentric(jde)
+	return coords.Spherical{Lon: l, Lat: b, R: r}.Cartesian()
+}
+
+// Given [jde], calculate geometric geocentric position of a planet,
+// corrected for light-time, referred to the mean equinox of the date
+// in the dynamical (VSOP87) frame.
+func (eph *Ephemeris) Geocentric(planet Planet, jde float64) Position {
+	earth := rectangular(&eph.earth, jde)
+	th := &eph.planets[planet]
+	tau := 0.0
+	var sph coords.Spherical
+	// the light-time converges after a few iterations
+	for i := 0; i < 5; i++ {
+		p := rectangular(th, jde-tau)
+		sph = coords.Cartesian{X: p.X - earth.X, Y: p.Y - earth.Y, Z: p.Z - earth.Z}.Spherical()
+		prev := tau
+		tau = LIGHT_TIME * sph.R
+		if math.Abs(tau-prev) < 1e-9 {
+			break
+		}
+	}
+	return Position{Lon: sph.Lon, Lat: sph.Lat, Delta: sph.R, LightTime: tau}
+}
+
+// Given [jde], calculate apparent geocentric position of a planet: the
+// geocentric one, converted to the FK5 system and corrected for
+// aberration and nutation in longitude.
+func (eph *Ephemeris) Apparent(planet Planet, jde float64) Position {
+	pos := eph.Geocentric(planet, jde)
+	t := (jde - julian.J2000) / julian.DAYS_PER_CENT
+	lon, lat := mathutils.Radians(pos.Lon), mathutils.Radians(pos.Lat)
+
+	// conversion to the FK5 system
+	l1 := mathutils.Radians(mathutils.Polynome(t, pos.Lon, -1.397, -0.00031))
+	dl := -0.09033 + 0.03916*(math.Cos(l1)+math.Sin(l1))*math.Tan(lat)
+	db := 0.03916 * (math.Cos(l1) - math.Sin(l1))
+
+	// aberration
+	el, _, _ := eph.earth.Heliocentric(jde)
+	sun := mathutils.Radians(el + 180) // true geometric longitude of the Sun
+	e := mathutils.Polynome(t, 0.016708634, -0.000042037, -0.0000001267)
+	pi := mathutils.Radians(mathutils.Polynome(t, 102.93735, 1.71946, 0.00046))
+	k := ABERRATION
+	al := (-k*math.Cos(sun-lon) + e*k*math.Cos(pi-lon)) / math.Cos(lat)
+	ab := -k * math.Sin(lat) * (math.Sin(sun-lon) - e*math.Sin(pi-lon))
+
+	dpsi, _ := nutequ.Nutation(jde)
+	pos.Lon = mathutils.ReduceDeg(pos.Lon + dl/3600 + al + dpsi)
+	pos.Lat += db/3600 + ab
+	return pos
+}
+
+// Given [jde], calculate apparent right ascension and declination of
+// a planet in arc-degrees.
+func (eph *Ephemeris) Equatorial(planet Planet, jde float64) (ra float64, dec float64) {
+	pos := eph.Apparent(planet, jde)
+	_, deps := nutequ.Nutation(jde)
+	return coords.EclipticToEquatorial(pos.Lon, pos.Lat, nutequ.TrueObliquity(jde, deps))
+}
+
+// Heliocentric position of a planet, see Ephemeris.Heliocentric.
+func Heliocentric(planet Planet, jde float64) (l float64, b float64, r float64) {
+	return Default.Heliocentric(planet, jde)
+}
+
+// Geometric geocentric position of a planet, see Ephemeris.Geocentric.
+func Geocentric(planet Planet, jde float64) Position {
+	return Default.Geocentric(planet, jde)
+}
+
+// Apparent geocentric position of a planet, see Ephemeris.Apparent.
+func Apparent(planet Planet, jde float64) Position {
+	return Default.Apparent(planet, jde)
+}
+
+// Apparent right ascension and declination of a planet, see
+// Ephemeris.Equatorial.
+func Equatorial(planet Planet, jde float64) (ra float64, dec float64) {
+	return Default.Equatorial(planet, jde)
+}
@@ -0,0 +1,159 @@
+package planets
+
+import (
+	"math"
+	"testing"
+
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/deltat"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/sun"
+)
+
+// 1992 Dec 20.0 TD, J.Meeus, "Astronomical Algorithms", example 33.a
+const _JDE = 2448976.5
+
+// One arc-second, in degrees
+const _ARCSEC = 1.0 / 3600
+
+func jdeOf(year, month int, day float64) float64 {
+	jd := julian.CivilToJulian(julian.CivilDate{Year: year, Month: month, Day: day})
+	return jd + deltat.DeltaT(jd)/86400
+}
+
+// Apparent elongation from the Sun in ecliptic longitude, arc-degrees.
+func elongation(p Planet, jde float64) float64 {
+	pos := Apparent(p, jde)
+	s := sun.Apparent(jde, sun.HighAccuracy)
+	return math.Remainder(pos.Lon-s.Lon, 360)
+}
+
+func TestGeocentric(t *testing.T) {
+	pos := Geocentric(Venus, _JDE)
+	if !mathutils.AlmostEqual(pos.Lon, 313.08102, _ARCSEC) {
+		t.Errorf("Expected: %f, got: %f", 313.08102, pos.Lon)
+	}
+	if !mathutils.AlmostEqual(pos.Lat, -2.08474, _ARCSEC) {
+		t.Errorf("Expected: %f, got: %f", -2.08474, pos.Lat)
+	}
+	if !mathutils.AlmostEqual(pos.Delta, 0.910947, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 0.910947, pos.Delta)
+	}
+	if !mathutils.AlmostEqual(pos.LightTime, 0.005261, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 0.005261, pos.LightTime)
+	}
+}
+
+func TestEquatorial(t *testing.T) {
+	ra, dec := Equatorial(Venus, _JDE)
+	// 21h04m41.454s, -18°53'16.84"
+	exp := mathutils.Polynome(1.0/60, 21, 4, 41.454) * 15
+	if !mathutils.AlmostEqual(ra, exp, _ARCSEC) {
+		t.Errorf("Expected: %f, got: %f", exp, ra)
+	}
+	exp = -mathutils.Polynome(1.0/60, 18, 53, 16.84)
+	if !mathutils.AlmostEqual(dec, exp, _ARCSEC) {
+		t.Errorf("Expected: %f, got: %f", exp, dec)
+	}
+}
+
+func TestTransits(t *testing.T) {
+	// minimal separation from the center of the Sun at mid-transit
+	cases := []struct {
+		planet Planet
+		year   int
+		month  int
+		day    float64
+		sep    float64 // arc-seconds
+	}{
+		{planet: Venus, year: 2004, month: 6, day: 8 + (8+20.0/60)/24, sep: 626.9},
+		{planet: Venus, year: 2012, month: 6, day: 6 + (1+29.0/60)/24, sep: 554.4},
+		{planet: Mercury, year: 2016, month: 5, day: 9 + (14+57.0/60)/24, sep: 318.5},
+		{planet: Mercury, year: 2019, month: 11, day: 11 + (15+20.0/60)/24, sep: 75.9},
+	}
+	for _, test := range cases {
+		jde := jdeOf(test.year, test.month, test.day)
+		ra, dec := Equatorial(test.planet, jde)
+		sra, sdec := sun.Equatorial(jde, sun.HighAccuracy)
+		got := coords.AngularSeparation(ra, dec, sra, sdec) * 3600
+		if !mathutils.AlmostEqual(got, test.sep, 2) {
+			t.Errorf("%s %d: expected: %f, got: %f", test.planet, test.year, test.sep, got)
+		}
+	}
+}
+
+func TestMars2003(t *testing.T) {
+	// the closest approach, 2003 Aug 27 9:51 UT, 0.372719 AU
+	jde := jdeOf(2003, 8, 27+(9+51.0/60)/24)
+	got := Geocentric(Mars, jde).Delta
+	if !mathutils.AlmostEqual(got, 0.372719, 2e-5) {
+		t.Errorf("Expected: %f, got: %f", 0.372719, got)
+	}
+	for _, h := range []float64{-6, 6} {
+		if d := Geocentric(Mars, jde+h/24).Delta; d <= got {
+			t.Errorf("Expected distance greater than %f, got: %f", got, d)
+		}
+	}
+}
+
+func TestGreatConjunction(t *testing.T) {
+	// Jupiter and Saturn, 2020 Dec 21, about 6.1'
+	best := math.Inf(1)
+	for h := 0.0; h < 48; h += 0.5 {
+		jde := jdeOf(2020, 12, 20+h/24)
+		ra1, dec1 := Equatorial(Jupiter, jde)
+		ra2, dec2 := Equatorial(Saturn, jde)
+		best = math.Min(best, coords.AngularSeparation(ra1, dec1, ra2, dec2)*60)
+	}
+	if !mathutils.AlmostEqual(best, 6.1, 0.05) {
+		t.Errorf("Expected: %f, got: %f", 6.1, best)
+	}
+}
+
+func TestOppositions(t *testing.T) {
+	cases := []struct {
+		planet Planet
+		year   int
+		month  int
+		day    float64
+	}{
+		{planet: Mars, year: 2022, month: 12, day: 8 + (5+36.0/60)/24},
+		{planet: Jupiter, year: 2023, month: 11, day: 3.2},
+		{planet: Saturn, year: 2023, month: 8, day: 27.35},
+		{planet: Uranus, year: 2023, month: 11, day: 13 + 17.0/24},
+		{planet: Neptune, year: 2023, month: 9, day: 19.5},
+	}
+	for _, test := range cases {
+		got := math.Abs(elongation(test.planet, jdeOf(test.year, test.month, test.day)))
+		if !mathutils.AlmostEqual(got, 180, 0.05) {
+			t.Errorf("%s: expected: %f, got: %f", test.planet, 180.0, got)
+		}
+	}
+}
+
+func TestEphemerisPrecision(t *testing.T) {
+	eph := NewEphemeris(1e-6)
+	jde := 2460000.5
+	for _, p := range Planets {
+		ra0, dec0 := Equatorial(p, jde)
+		ra1, dec1 := eph.Equatorial(p, jde)
+		got := coords.AngularSeparation(ra0, dec0, ra1, dec1)
+		if got > _ARCSEC {
+			t.Errorf("%s: expected less than 1\", got: %f\"", p, got*3600)
+		}
+	}
+}
+
+func benchmarkEphemeris(b *testing.B, precision float64) {
+	eph := NewEphemeris(precision)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		eph.Geocentric(Jupiter, 2460000.5+float64(i%3650))
+	}
+}
+
+func BenchmarkEphemeris(b *testing.B) {
+	b.Run("complete", func(b *testing.B) { benchmarkEphemeris(b, 0) })
+	b.Run("1e-6", func(b *testing.B) { benchmarkEphemeris(b, 1e-6) })
+}
@@ -0,0 +1,177 @@
+// Times of rising, transit and setting of celestial bodies.
+//
+// A body is described by a position function, which returns its apparent
+// right ascension and declination for a given moment. Built-in providers
+// for the Sun and the Moon are SunPosition and MoonPosition.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, chapter 15.
+package riseset
+
+import (
+	"context"
+	"math"
+
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/deltat"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/moon"
+	"github.com/skrushinsky/scaliger/observer"
+	"github.com/skrushinsky/scaliger/sidereal"
+	"github.com/skrushinsky/scaliger/sun"
+)
+
+// Standard altitudes of the centers of bodies at rising and setting,
+// corrected for atmospheric refraction and semidiameters, arc-degrees.
+const (
+	STARS_HORIZON = -0.5667
+	SUN_HORIZON   = -0.8333
+	// mean value, the exact one depends on the Moon's parallax
+	MOON_HORIZON = 0.125
+)
+
+// Scan step, days
+const _STEP = 1.0 / 48
+
+// Required precision, days
+const _PRECISION = 1e-6
+
+// Geographical position of the observer.
+type Observer = observer.Observer
+
+// Given UT Julian Date, returns apparent right ascension and declination
+// of a body, arc-degrees.
+type PositionFunc func(jd float64) (ra float64, dec float64)
+
+// Circumstances of the daily motion.
+type Circumstance int
+
+const (
+	// The body crosses the horizon.
+	Normal Circumstance = iota
+	// The body stays above the horizon during the whole day.
+	Circumpolar
+	// The body stays below the horizon during the whole day.
+	NeverRises
+)
+
+// Times of rising, transit and setting, UT Julian Dates. An event which
+// does not occur within the day, e.g. moonrise on some days of the
+// month, is NaN.
+type Events struct {
+	Rise         float64
+	Transit      float64
+	Set          float64
+	Circumstance Circumstance
+}
+
+// Apparent position of the Sun, a PositionFunc.
+func SunPosition(jd float64) (ra float64, dec float64) {
+	return sun.Equatorial(jd+deltat.DeltaT(jd)/julian.SEC_PER_DAY, sun.HighAccuracy)
+}
+
+// Apparent geocentric position of the Moon, a PositionFunc.
+func MoonPosition(jd float64) (ra float64, dec float64) {
+	return moon.Equatorial(jd + deltat.DeltaT(jd)/julian.SEC_PER_DAY)
+}
+
+// Dip of the horizon for the observer's height, arc-degrees.
+func dip(height float64) float64 {
+	if height <= 0 {
+		return 0
+	}
+	return 1.76 / 60 * math.Sqrt(height)
+}
+
+// Mean midnight of the observer's local civil [date], UT Julian Date.
+func localMidnight(obs Observer, date julian.CivilDate) float64 {
+	jd0 := julian.CivilToJulian(julian.CivilDate{Year: date.Year, Month: date.Month, Day: math.Floor(date.Day)})
+	return jd0 - obs.Lng/360
+}
+
+// Returns function of UT Julian Date, which gives altitude of a body above
+// [h0], arc-degrees.
+func altitudeFunc(obs Observer, pos PositionFunc, h0 float64) func(float64) float64 {
+	opts := obs.SiderealOptions()
+	return func(jd float64) float64 {
+		ra, dec := pos(jd)
+		lst := sidereal.JulianToSidereal(jd, opts)
+		_, alt := coords.EquatorialToHorizontal(coords.HourAngleDeg(lst, ra), dec, obs.Lat)
+		return alt - h0
+	}
+}
+
+// Finds moments between [start] and [end] when [f] changes sign: from
+// negative to positive (rises) and from positive to negative (sets).
+// The third value shows whether f is positive at the start.
+func crossings(f func(float64) float64, start, end float64) (rises []float64, sets []float64, positive bool, err error) {
+	scan := mathutils.Scan{Start: start, End: end, Step: _STEP, Tolerance: _PRECISION}
+	events, err := mathutils.FindCrossings(context.Background(), f, 0, scan)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	for _, ev := range events {
+		if ev.Rising {
+			rises = append(rises, ev.X)
+		} else {
+			sets = append(sets, ev.X)
+		}
+	}
+	return rises, sets, f(start) > 0, nil
+}
+
+// Given [obs], observer's position, a local civil [date], a position
+// function and [horizon], altitude of the body's center at rising and
+// setting in arc-degrees (e.g. SUN_HORIZON), calculate times of rising,
+// transit and setting.
+//
+// The local date starts at the observer's mean midnight; time part of the
+// date is ignored. Horizon altitude is corrected for the dip of the horizon
+// due to the observer's height. The error comes from refinement of the
+// events, see mathutils.FindCrossings.
+//
+//	obs := Observer{Lng: 37.5833, Lat: 55.75}
+//	date := julian.CivilDate{Year: 2024, Month: 3, Day: 2}
+//	ev, err := RiseTransitSet(obs, date, SunPosition, SUN_HORIZON)
+func RiseTransitSet(obs Observer, date julian.CivilDate, pos PositionFunc, horizon float64) (Events, error) {
+	start := localMidnight(obs, date)
+	end := start + 1
+	opts := obs.SiderealOptions()
+	// sine of the hour angle is continuous, unlike the hour angle itself,
+	// which jumps from +12 to -12 at the lower transit
+	sinHourAngle := func(jd float64) float64 {
+		ra, _ := pos(jd)
+		ha := sidereal.HourAngle(jd, ra/15, sidereal.SiderealUnits, opts)
+		return math.Sin(mathutils.Radians(ha * 15))
+	}
+
+	res := Events{Rise: math.NaN(), Transit: math.NaN(), Set: math.NaN()}
+	rises, sets, above, err := crossings(altitudeFunc(obs, pos, horizon-dip(obs.Height)), start, end)
+	if err != nil {
+		return res, err
+	}
+	if len(rises) > 0 {
+		res.Rise = rises[0]
+	}
+	if len(sets) > 0 {
+		res.Set = sets[0]
+	}
+	if len(rises) == 0 && len(sets) == 0 {
+		if above {
+			res.Circumstance = Circumpolar
+		} else {
+			res.Circumstance = NeverRises
+		}
+	}
+
+	// the sine increases through zero at the upper transit and decreases
+	// at the lower one
+	transits, _, _, err := crossings(sinHourAngle, start, end)
+	if err != nil {
+		return res, err
+	}
+	if len(transits) > 0 {
+		res.Transit = transits[0]
+	}
+	return res, nil
+}
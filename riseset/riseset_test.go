@@ -0,0 +1,114 @@
+package riseset
+
+import (
+	"math"
+	"testing"
+
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/sidereal"
+)
+
+// Venus, 1988 March 19-21, 0h TD, J.Meeus, "Astronomical Algorithms", example 15.a
+func venus(jd float64) (float64, float64) {
+	jd0 := 2447240.5 // 1988 March 20
+	ra := mathutils.Polynome(jd-jd0, 41.73129, 1.05092)
+	dec := mathutils.Polynome(jd-jd0, 18.44092, 0.38991)
+	return ra, dec
+}
+
+var boston = Observer{Lng: -71.0833, Lat: 42.3333}
+
+// Calls RiseTransitSet and stops the test on error.
+func riseTransitSet(t *testing.T, obs Observer, date julian.CivilDate, pos PositionFunc, horizon float64) Events {
+	t.Helper()
+	ev, err := RiseTransitSet(obs, date, pos, horizon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ev
+}
+
+func hours(jd float64) float64 {
+	return julian.ExtractUTC(jd)
+}
+
+func TestVenus(t *testing.T) {
+	ev := riseTransitSet(t, boston, julian.CivilDate{Year: 1988, Month: 3, Day: 20}, venus, STARS_HORIZON)
+	if ev.Circumstance != Normal {
+		t.Fatalf("Expected normal circumstances, got: %d", ev.Circumstance)
+	}
+	// Meeus: rising 12h25m, transit 19h41m UT
+	if got := hours(ev.Rise); !mathutils.AlmostEqual(got, 12+25.0/60, 1.5/60) {
+		t.Errorf("Expected: %f, got: %f", 12+25.0/60, got)
+	}
+	if got := hours(ev.Transit); !mathutils.AlmostEqual(got, 19+41.0/60, 1.5/60) {
+		t.Errorf("Expected: %f, got: %f", 19+41.0/60, got)
+	}
+	// setting occurs after the UT midnight, on the next UT date
+	if ev.Set < ev.Transit || julian.JulianToCivil(ev.Set).Day < 21 {
+		t.Errorf("Setting should occur on March 21, UT, got: %f", ev.Set)
+	}
+}
+
+func TestSunAltitude(t *testing.T) {
+	obs := Observer{Lng: 37.5833, Lat: 55.75}
+	ev := riseTransitSet(t, obs, julian.CivilDate{Year: 2024, Month: 3, Day: 2}, SunPosition, SUN_HORIZON)
+	opts := sidereal.SiderealOptions{Lng: obs.Lng, Kind: sidereal.KindAutoApparent}
+	for _, jd := range []float64{ev.Rise, ev.Set} {
+		ra, dec := SunPosition(jd)
+		_, alt := coords.EquatorialToHorizontalAt(jd, ra, dec, obs.Lat, opts)
+		if !mathutils.AlmostEqual(alt, SUN_HORIZON, 1e-3) {
+			t.Errorf("Expected: %f, got: %f", SUN_HORIZON, alt)
+		}
+	}
+	if !(ev.Rise < ev.Transit && ev.Transit < ev.Set) {
+		t.Errorf("Wrong order of events: %v", ev)
+	}
+	// local apparent noon is close to 12h local mean time minus equation of time (12m)
+	noon := hours(ev.Transit) + obs.Lng/15
+	if !mathutils.AlmostEqual(noon, 12+12.3/60, 1.0/60) {
+		t.Errorf("Expected: %f, got: %f", 12+12.3/60, noon)
+	}
+}
+
+func TestPolar(t *testing.T) {
+	tromso := Observer{Lng: 18.96, Lat: 69.65}
+	ev := riseTransitSet(t, tromso, julian.CivilDate{Year: 2024, Month: 6, Day: 21}, SunPosition, SUN_HORIZON)
+	if ev.Circumstance != Circumpolar {
+		t.Errorf("Expected circumpolar, got: %d", ev.Circumstance)
+	}
+	if !math.IsNaN(ev.Rise) || !math.IsNaN(ev.Set) || math.IsNaN(ev.Transit) {
+		t.Errorf("Expected transit only, got: %v", ev)
+	}
+	ev = riseTransitSet(t, tromso, julian.CivilDate{Year: 2024, Month: 12, Day: 21}, SunPosition, SUN_HORIZON)
+	if ev.Circumstance != NeverRises {
+		t.Errorf("Expected never rising, got: %d", ev.Circumstance)
+	}
+}
+
+func TestMoon(t *testing.T) {
+	obs := Observer{Lng: 37.5833, Lat: 55.75}
+	// the Moon rises about 50 minutes later every day, so once a month
+	// there is a day without moonrise
+	missed := 0
+	for day := 2.0; day <= 29; day++ {
+		ev := riseTransitSet(t, obs, julian.CivilDate{Year: 2024, Month: 3, Day: day}, MoonPosition, MOON_HORIZON)
+		if math.IsNaN(ev.Rise) {
+			missed++
+		}
+	}
+	if missed != 1 {
+		t.Errorf("Expected 1 day without moonrise, got: %d", missed)
+	}
+}
+
+func TestHeight(t *testing.T) {
+	date := julian.CivilDate{Year: 2024, Month: 3, Day: 2}
+	low := riseTransitSet(t, Observer{Lng: 37.5833, Lat: 55.75}, date, SunPosition, SUN_HORIZON)
+	high := riseTransitSet(t, Observer{Lng: 37.5833, Lat: 55.75, Height: 1000}, date, SunPosition, SUN_HORIZON)
+	if high.Rise >= low.Rise || high.Set <= low.Set {
+		t.Errorf("The day should be longer for an elevated observer")
+	}
+}
@@ -0,0 +1,150 @@
+package riseset
+
+import (
+	"math"
+	"sort"
+
+	"github.com/skrushinsky/scaliger/julian"
+)
+
+// Altitudes of the Sun's center at the beginning and the end of twilights,
+// arc-degrees.
+const (
+	CIVIL_TWILIGHT        = -6.0
+	NAUTICAL_TWILIGHT     = -12.0
+	ASTRONOMICAL_TWILIGHT = -18.0
+)
+
+// Time interval, UT Julian Dates.
+type Interval struct {
+	Start float64
+	End   float64
+}
+
+// Circumstances of a night.
+//
+// Start of a twilight interval is the evening dusk, when the Sun sinks below
+// the twilight altitude; End is the next morning dawn. Events which do not
+// occur during the night are NaN.
+type Night struct {
+	// sunset of the date
+	Sunset float64
+	// sunrise of the next morning
+	Sunrise      float64
+	Civil        Interval
+	Nautical     Interval
+	Astronomical Interval
+	// intervals of astronomical darkness, empty if there is none
+	Darkness []Interval
+	// the Sun does not set
+	MidnightSun bool
+	// the Sun does not rise
+	PolarNight bool
+}
+
+// Finds intervals between [start] and [end] when [f] is negative.
+func negativeIntervals(f func(float64) float64, start, end float64) ([]Interval, error) {
+	rises, sets, _, err := crossings(f, start, end)
+	if err != nil {
+		return nil, err
+	}
+	events := append(append([]float64{}, rises...), sets...)
+	sort.Float64s(events)
+
+	var res []Interval
+	below := f(start) < 0
+	opened := start
+	for _, t := range events {
+		if below {
+			res = append(res, Interval{Start: opened, End: t})
+		} else {
+			opened = t
+		}
+		below = !below
+	}
+	if below {
+		res = append(res, Interval{Start: opened, End: end})
+	}
+	return res, nil
+}
+
+// Given the Sun's altitude function, finds evening crossing of the altitude
+// and the morning one. The second value shows whether f is positive at the
+// start.
+func duskAndDawn(f func(float64) float64, start, end float64) (Interval, bool, error) {
+	res := Interval{Start: math.NaN(), End: math.NaN()}
+	rises, sets, positive, err := crossings(f, start, end)
+	if err != nil {
+		return res, false, err
+	}
+	if len(sets) > 0 {
+		res.Start = sets[0]
+	}
+	if len(rises) > 0 {
+		res.End = rises[len(rises)-1]
+	}
+	return res, positive, nil
+}
+
+// Given [obs], observer's position, and a local civil [date], calculate
+// circumstances of the night, which starts at the local mean noon of the
+// date and ends at the next noon. The error comes from refinement of the
+// events, see mathutils.FindCrossings.
+func Twilight(obs Observer, date julian.CivilDate) (Night, error) {
+	start := localMidnight(obs, date) + 0.5
+	end := start + 1
+	horizon := SUN_HORIZON - dip(obs.Height)
+
+	var res Night
+	sunset, above, err := duskAndDawn(altitudeFunc(obs, SunPosition, horizon), start, end)
+	if err != nil {
+		return res, err
+	}
+	res.Sunset, res.Sunrise = sunset.Start, sunset.End
+	if res.Civil, _, err = duskAndDawn(altitudeFunc(obs, SunPosition, CIVIL_TWILIGHT), start, end); err != nil {
+		return res, err
+	}
+	if res.Nautical, _, err = duskAndDawn(altitudeFunc(obs, SunPosition, NAUTICAL_TWILIGHT), start, end); err != nil {
+		return res, err
+	}
+	astro := altitudeFunc(obs, SunPosition, ASTRONOMICAL_TWILIGHT)
+	if res.Astronomical, _, err = duskAndDawn(astro, start, end); err != nil {
+		return res, err
+	}
+	if res.Darkness, err = negativeIntervals(astro, start, end); err != nil {
+		return res, err
+	}
+
+	if math.IsNaN(res.Sunset) && math.IsNaN(res.Sunrise) {
+		res.MidnightSun = above
+		res.PolarNight = !above
+	}
+	return res, nil
+}
+
+// Given [obs], observer's position, and a local civil [date], calculate
+// astronomical darkness windows: intervals of the night when the Sun's
+// altitude is below [altitude], e.g. ASTRONOMICAL_TWILIGHT.
+func DarknessWindows(obs Observer, date julian.CivilDate, altitude float64) ([]Interval, error) {
+	start := localMidnight(obs, date) + 0.5
+	return negativeIntervals(altitudeFunc(obs, SunPosition, altitude), start, start+1)
+}
+
+// Given [obs], observer's position, and a local civil [date], calculate
+// length of the day in hours: time the Sun spends above the horizon between
+// local midnights.
+// It is 24 during the midnight sun and 0 during the polar night.
+func DayLength(obs Observer, date julian.CivilDate) (float64, error) {
+	start := localMidnight(obs, date)
+	alt := altitudeFunc(obs, SunPosition, SUN_HORIZON-dip(obs.Height))
+	below := func(jd float64) float64 { return -alt(jd) }
+	intervals, err := negativeIntervals(below, start, start+1)
+	if err != nil {
+		return 0, err
+	}
+	res := 0.0
+	for _, iv := range intervals {
+		res += iv.End - iv.Start
+	}
+	return res * 24, nil
+}
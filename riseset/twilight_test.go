@@ -0,0 +1,127 @@
+package riseset
+
+import (
+	"math"
+	"testing"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// Calls Twilight and stops the test on error.
+func twilight(t *testing.T, obs Observer, date julian.CivilDate) Night {
+	t.Helper()
+	night, err := Twilight(obs, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return night
+}
+
+// Calls DayLength and stops the test on error.
+func dayLength(t *testing.T, obs Observer, date julian.CivilDate) float64 {
+	t.Helper()
+	res, err := DayLength(obs, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestTwilight(t *testing.T) {
+	obs := Observer{Lng: 37.5833, Lat: 55.75}
+	date := julian.CivilDate{Year: 2024, Month: 3, Day: 2}
+	night := twilight(t, obs, date)
+	if night.MidnightSun || night.PolarNight {
+		t.Fatalf("Expected ordinary night")
+	}
+	// events should follow in order
+	seq := []float64{
+		night.Sunset,
+		night.Civil.Start,
+		night.Nautical.Start,
+		night.Astronomical.Start,
+		night.Astronomical.End,
+		night.Nautical.End,
+		night.Civil.End,
+		night.Sunrise,
+	}
+	for i := 1; i < len(seq); i++ {
+		if !(seq[i] > seq[i-1]) {
+			t.Errorf("Wrong order of events: %v", seq)
+		}
+	}
+	if len(night.Darkness) != 1 {
+		t.Fatalf("Expected single darkness window, got: %d", len(night.Darkness))
+	}
+	if night.Darkness[0] != night.Astronomical {
+		t.Errorf("Expected: %v, got: %v", night.Astronomical, night.Darkness[0])
+	}
+	// sunset should match the one found by RiseTransitSet
+	ev := riseTransitSet(t, obs, date, SunPosition, SUN_HORIZON)
+	if !mathutils.AlmostEqual(night.Sunset, ev.Set, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", ev.Set, night.Sunset)
+	}
+}
+
+func TestDayLength(t *testing.T) {
+	obs := Observer{Lng: 37.5833, Lat: 55.75}
+	date := julian.CivilDate{Year: 2024, Month: 3, Day: 2}
+	ev := riseTransitSet(t, obs, date, SunPosition, SUN_HORIZON)
+	exp := (ev.Set - ev.Rise) * 24
+	if got := dayLength(t, obs, date); !mathutils.AlmostEqual(got, exp, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+}
+
+func TestMidnightSun(t *testing.T) {
+	tromso := Observer{Lng: 18.96, Lat: 69.65}
+	date := julian.CivilDate{Year: 2024, Month: 6, Day: 21}
+	night := twilight(t, tromso, date)
+	if !night.MidnightSun || night.PolarNight {
+		t.Errorf("Expected midnight sun")
+	}
+	if !math.IsNaN(night.Civil.Start) || len(night.Darkness) != 0 {
+		t.Errorf("Expected no twilight, got: %v", night)
+	}
+	if got := dayLength(t, tromso, date); got != 24 {
+		t.Errorf("Expected: 24, got: %f", got)
+	}
+}
+
+func TestPolarNight(t *testing.T) {
+	tromso := Observer{Lng: 18.96, Lat: 69.65}
+	date := julian.CivilDate{Year: 2024, Month: 12, Day: 21}
+	night := twilight(t, tromso, date)
+	if night.MidnightSun || !night.PolarNight {
+		t.Errorf("Expected polar night")
+	}
+	if got := dayLength(t, tromso, date); got != 0 {
+		t.Errorf("Expected: 0, got: %f", got)
+	}
+	if len(night.Darkness) != 1 {
+		t.Errorf("Expected single darkness window, got: %d", len(night.Darkness))
+	}
+}
+
+func TestNoAstronomicalDarkness(t *testing.T) {
+	london := Observer{Lng: -0.1275, Lat: 51.5072}
+	date := julian.CivilDate{Year: 2024, Month: 6, Day: 21}
+	night := twilight(t, london, date)
+	if len(night.Darkness) != 0 {
+		t.Errorf("Expected no darkness, got: %v", night.Darkness)
+	}
+	if !math.IsNaN(night.Astronomical.Start) || !math.IsNaN(night.Astronomical.End) {
+		t.Errorf("Expected no astronomical twilight, got: %v", night.Astronomical)
+	}
+	if math.IsNaN(night.Nautical.Start) || math.IsNaN(night.Nautical.End) {
+		t.Errorf("Expected nautical twilight, got: %v", night.Nautical)
+	}
+	windows, err := DarknessWindows(london, date, NAUTICAL_TWILIGHT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(windows) != 1 || windows[0] != night.Nautical {
+		t.Errorf("Expected: %v, got: %v", night.Nautical, windows)
+	}
+}
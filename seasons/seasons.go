@@ -0,0 +1,174 @@
+// Instants of equinoxes and solstices: the moments when apparent geocentric
+// longitude of the Sun is a multiple of 90 degrees.
+//
+// Fast method uses polynomial approximations with periodic terms and is
+// accurate to about a minute for years 1951-2050. Refined method corrects
+// the result iteratively against the high accuracy solar ephemeris.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, chapter 27.
+package seasons
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/skrushinsky/scaliger/deltat"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/sun"
+)
+
+// Equinoxes and solstices.
+type Season int
+
+const (
+	MarchEquinox Season = iota
+	JuneSolstice
+	SeptemberEquinox
+	DecemberSolstice
+)
+
+// Calculation method.
+type Method int
+
+const (
+	// Polynomial approximation with periodic terms.
+	Fast Method = iota
+	// Fast method, refined against the solar ephemeris.
+	Refined
+)
+
+// Instant of an equinox or a solstice.
+type Event struct {
+	Season Season
+	// Julian Ephemeris Date, Terrestrial Time
+	TT float64
+	// Julian Date, Universal Time
+	UT float64
+}
+
+// Calendar date of the event, Terrestrial Time.
+func (e Event) CivilTT() julian.CivilDate {
+	return julian.JulianToCivil(e.TT)
+}
+
+// Calendar date of the event, Universal Time.
+func (e Event) CivilUT() julian.CivilDate {
+	return julian.JulianToCivil(e.UT)
+}
+
+// Mean instants for years -1000 to +1000, J.Meeus, table 27.A.
+var _TERMS_A = [...][5]float64{
+	{1721139.29189, 365242.13740, 0.06134, 0.00111, -0.00071},
+	{1721233.25401, 365241.72562, -0.05323, 0.00907, 0.00025},
+	{1721325.70455, 365242.49558, -0.11677, -0.00297, 0.00074},
+	{1721414.39987, 365242.88257, -0.00769, -0.00933, -0.00006},
+}
+
+// Mean instants for years +1000 to +3000, J.Meeus, table 27.B.
+var _TERMS_B = [...][5]float64{
+	{2451623.80984, 365242.37404, 0.05169, -0.00411, -0.00057},
+	{2451716.56767, 365241.62603, 0.00325, 0.00888, -0.00030},
+	{2451810.21715, 365242.01767, -0.11575, 0.00337, 0.00078},
+	{2451900.05952, 365242.74049, -0.06223, -0.00823, 0.00032},
+}
+
+// Periodic terms, J.Meeus, table 27.C.
+var _PERIODIC = [...][3]float64{
+	{485, 324.96, 1934.136},
+	{203, 337.23, 32964.467},
+	{199, 342.08, 20.186},
+	{182, 27.85, 445267.112},
+	{156, 73.14, 45036.886},
+	{136, 171.52, 22518.443},
+	{77, 222.54, 65928.934},
+	{74, 296.72, 3034.906},
+	{70, 243.58, 9037.513},
+	{58, 119.81, 33718.147},
+	{52, 297.17, 150.678},
+	{50, 21.02, 2281.226},
+	{45, 247.54, 29929.562},
+	{44, 325.15, 31555.956},
+	{29, 60.93, 4443.417},
+	{18, 155.12, 67555.328},
+	{17, 288.79, 4562.452},
+	{16, 198.04, 62894.029},
+	{14, 199.76, 31436.921},
+	{12, 95.39, 14577.848},
+	{12, 287.11, 31931.756},
+	{12, 320.81, 34777.259},
+	{9, 227.73, 1222.114},
+	{8, 15.45, 16859.074},
+}
+
+// Given a [year] and a [season], calculate Julian Ephemeris Date of the
+// event with polynomial approximation.
+func fast(year int, season Season) float64 {
+	var jde0 float64
+	if year < 1000 {
+		jde0 = mathutils.Polynome(float64(year)/1000, _TERMS_A[season][:]...)
+	} else {
+		jde0 = mathutils.Polynome(float64(year-2000)/1000, _TERMS_B[season][:]...)
+	}
+	t := (jde0 - julian.J2000) / julian.DAYS_PER_CENT
+	w := mathutils.Radians(35999.373*t - 2.47)
+	dl := 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+	s := 0.0
+	for _, term := range _PERIODIC {
+		s += term[0] * math.Cos(mathutils.Radians(term[1]+term[2]*t))
+	}
+	return jde0 + 0.00001*s/dl
+}
+
+// Returned by Find when the refined instant is not found near the fast
+// estimate.
+var ErrNotFound = errors.New("seasons: event is not found near the estimate")
+
+// Refines [jde] so that apparent longitude of the Sun equals the season's
+// longitude, searching two days before and after it.
+func refine(jde float64, season Season) (float64, error) {
+	target := float64(season) * 90
+	// sine of the difference increases through zero at the event
+	f := func(t float64) float64 {
+		lon := sun.Apparent(t, sun.HighAccuracy).Lon
+		return math.Sin(mathutils.Radians(lon - target))
+	}
+	scan := mathutils.Scan{Start: jde - 2, End: jde + 2, Step: 0.5, Tolerance: 1e-7}
+	events, err := mathutils.FindCrossings(context.Background(), f, 0, scan)
+	if err != nil {
+		return 0, err
+	}
+	for _, ev := range events {
+		if ev.Rising {
+			return ev.X, nil
+		}
+	}
+	return 0, ErrNotFound
+}
+
+// Given a [year] and a [season], find instant of the equinox or solstice.
+// Only the Refined method may return an error.
+func Find(year int, season Season, method Method) (Event, error) {
+	jde := fast(year, season)
+	if method == Refined {
+		var err error
+		if jde, err = refine(jde, season); err != nil {
+			return Event{}, err
+		}
+	}
+	return Event{Season: season, TT: jde, UT: jde - deltat.DeltaT(jde)/julian.SEC_PER_DAY}, nil
+}
+
+// Given a [year], find instants of all the equinoxes and solstices.
+func Year(year int, method Method) ([4]Event, error) {
+	var res [4]Event
+	for s := MarchEquinox; s <= DecemberSolstice; s++ {
+		ev, err := Find(year, s, method)
+		if err != nil {
+			return res, err
+		}
+		res[s] = ev
+	}
+	return res, nil
+}
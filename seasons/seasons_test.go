@@ -0,0 +1,77 @@
+package seasons
+
+import (
+	"testing"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/sun"
+)
+
+func TestFast(t *testing.T) {
+	// J.Meeus, "Astronomical Algorithms", example 27.a
+	got, err := Find(1962, JuneSolstice, Fast)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mathutils.AlmostEqual(got.TT, 2437837.39245, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", 2437837.39245, got.TT)
+	}
+}
+
+func TestRefined(t *testing.T) {
+	// Meeus gives 21h24m42s TD as the correct instant
+	got, err := Find(1962, JuneSolstice, Refined)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := julian.CivilToJulian(julian.CivilDate{Year: 1962, Month: 6, Day: 21 + (21+24.0/60+42.0/3600)/24})
+	if !mathutils.AlmostEqual(got.TT, exp, 2.0/julian.SEC_PER_DAY) {
+		t.Errorf("Expected: %s, got: %s", julian.JulianToDateString(exp), julian.JulianToDateString(got.TT))
+	}
+	lon := sun.Apparent(got.TT, sun.HighAccuracy).Lon
+	if !mathutils.AlmostEqual(lon, 90, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 90.0, lon)
+	}
+}
+
+func TestYear(t *testing.T) {
+	exp := [4]julian.CivilDate{
+		{Year: 2024, Month: 3, Day: 20 + (3+6.0/60)/24},
+		{Year: 2024, Month: 6, Day: 20 + (20+51.0/60)/24},
+		{Year: 2024, Month: 9, Day: 22 + (12+44.0/60)/24},
+		{Year: 2024, Month: 12, Day: 21 + (9+20.0/60)/24},
+	}
+	events, err := Year(2024, Refined)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, ev := range events {
+		if ev.Season != Season(i) {
+			t.Errorf("Expected season: %d, got: %d", i, ev.Season)
+		}
+		got := ev.CivilUT()
+		if got.Month != exp[i].Month || !mathutils.AlmostEqual(got.Day, exp[i].Day, 2.0/1440) {
+			t.Errorf("Expected: %v, got: %v", exp[i], got)
+		}
+	}
+}
+
+func TestAncient(t *testing.T) {
+	// the instant should be close to the fast estimate before the year 1000
+	fast, _ := Find(-500, MarchEquinox, Fast)
+	refined, err := Find(-500, MarchEquinox, Refined)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mathutils.AlmostEqual(fast.TT, refined.TT, 0.01) {
+		t.Errorf("Expected: %f, got: %f", fast.TT, refined.TT)
+	}
+}
+
+func TestRefineNotFound(t *testing.T) {
+	// estimate a month off: the event is out of the search window
+	if _, err := refine(fast(2024, MarchEquinox)+30, MarchEquinox); err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got: %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package sidereal
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// Complementary terms of the equation of the equinoxes.
+type ComplementaryTerms int
+
+const (
+	// Classical equation of the equinoxes, Δψ·cos ε only.
+	CTNone ComplementaryTerms = iota
+	// IAU 1994 resolution: Ω and 2Ω terms.
+	CTIAU1994
+	// IAU 2000 series, IERS Conventions 2003/2010.
+	CTIAU2000
+)
+
+// arcseconds in a full circle
+const _TURNAS = 1296000.0
+
+// arcseconds to radians
+const _AS2R = math.Pi / 648000
+
+// Term of the IAU 2000 complementary terms series.
+type _CTTerm struct {
+	// multipliers of l, l', F, D, Ω, L(Venus), L(Earth), pA
+	n [8]float64
+	// sine and cosine coefficients, arcseconds
+	s, c float64
+}
+
+// IAU 2000 complementary terms, order 0 (SOFA eect00).
+var _CT0 = [...]_CTTerm{
+	{[8]float64{0, 0, 0, 0, 1, 0, 0, 0}, 2640.96e-6, -0.39e-6},
+	{[8]float64{0, 0, 0, 0, 2, 0, 0, 0}, 63.52e-6, -0.02e-6},
+	{[8]float64{0, 0, 2, -2, 3, 0, 0, 0}, 11.75e-6, 0.01e-6},
+	{[8]float64{0, 0, 2, -2, 1, 0, 0, 0}, 11.21e-6, 0.01e-6},
+	{[8]float64{0, 0, 2, -2, 2, 0, 0, 0}, -4.55e-6, 0.00e-6},
+	{[8]float64{0, 0, 2, 0, 3, 0, 0, 0}, 2.02e-6, 0.00e-6},
+	{[8]float64{0, 0, 2, 0, 1, 0, 0, 0}, 1.98e-6, 0.00e-6},
+	{[8]float64{0, 0, 0, 0, 3, 0, 0, 0}, -1.72e-6, 0.00e-6},
+	{[8]float64{0, 1, 0, 0, 1, 0, 0, 0}, -1.41e-6, -0.01e-6},
+	{[8]float64{0, 1, 0, 0, -1, 0, 0, 0}, -1.26e-6, -0.01e-6},
+	{[8]float64{1, 0, 0, 0, -1, 0, 0, 0}, -0.63e-6, 0.00e-6},
+	{[8]float64{1, 0, 0, 0, 1, 0, 0, 0}, -0.63e-6, 0.00e-6},
+	{[8]float64{0, 1, 2, -2, 3, 0, 0, 0}, 0.46e-6, 0.00e-6},
+	{[8]float64{0, 1, 2, -2, 1, 0, 0, 0}, 0.45e-6, 0.00e-6},
+	{[8]float64{0, 0, 4, -4, 4, 0, 0, 0}, 0.36e-6, 0.00e-6},
+	{[8]float64{0, 0, 1, -1, 1, -8, 12, 0}, -0.24e-6, -0.12e-6},
+	{[8]float64{0, 0, 2, 0, 0, 0, 0, 0}, 0.32e-6, 0.00e-6},
+	{[8]float64{0, 0, 2, 0, 2, 0, 0, 0}, 0.28e-6, 0.00e-6},
+	{[8]float64{1, 0, 2, 0, 3, 0, 0, 0}, 0.27e-6, 0.00e-6},
+	{[8]float64{1, 0, 2, 0, 1, 0, 0, 0}, 0.26e-6, 0.00e-6},
+	{[8]float64{0, 0, 2, -2, 0, 0, 0, 0}, -0.21e-6, 0.00e-6},
+	{[8]float64{0, 1, -2, 2, -3, 0, 0, 0}, 0.19e-6, 0.00e-6},
+	{[8]float64{0, 1, -2, 2, -1, 0, 0, 0}, 0.18e-6, 0.00e-6},
+	{[8]float64{0, 0, 0, 0, 0, 8, -13, -1}, -0.10e-6, 0.05e-6},
+	{[8]float64{0, 0, 0, 2, 0, 0, 0, 0}, 0.15e-6, 0.00e-6},
+	{[8]float64{2, 0, -2, 0, -1, 0, 0, 0}, -0.14e-6, 0.00e-6},
+	{[8]float64{1, 0, 0, -2, 1, 0, 0, 0}, 0.14e-6, 0.00e-6},
+	{[8]float64{0, 1, 2, -2, 2, 0, 0, 0}, -0.14e-6, 0.00e-6},
+	{[8]float64{1, 0, 0, -2, -1, 0, 0, 0}, 0.14e-6, 0.00e-6},
+	{[8]float64{0, 0, 4, -2, 4, 0, 0, 0}, 0.13e-6, 0.00e-6},
+	{[8]float64{0, 0, 2, -2, 4, 0, 0, 0}, -0.11e-6, 0.00e-6},
+	{[8]float64{1, 0, -2, 0, -3, 0, 0, 0}, 0.11e-6, 0.00e-6},
+	{[8]float64{1, 0, -2, 0, -1, 0, 0, 0}, 0.11e-6, 0.00e-6},
+}
+
+// IAU 2000 complementary terms, order 1.
+var _CT1 = [...]_CTTerm{
+	{[8]float64{0, 0, 0, 0, 1, 0, 0, 0}, -0.87e-6, 0.00e-6},
+}
+
+// Fundamental arguments in radians, IERS Conventions 2003.
+// t is a number of Julian centuries elapsed since J2000.
+func fundamentalArgs(t float64) [8]float64 {
+	arcsec := func(terms ...float64) float64 {
+		return math.Mod(mathutils.Polynome(t, terms...), _TURNAS) * _AS2R
+	}
+	return [8]float64{
+		arcsec(485868.249036, 1717915923.2178, 31.8792, 0.051635, -0.00024470),  // l, mean anomaly of the Moon
+		arcsec(1287104.793048, 129596581.0481, -0.5532, 0.000136, -0.00001149),  // l', mean anomaly of the Sun
+		arcsec(335779.526232, 1739527262.8478, -12.7512, -0.001037, 0.00000417), // F = L - Ω
+		arcsec(1072260.703692, 1602961601.2090, -6.3706, 0.006593, -0.00003169), // D, mean elongation of the Moon from the Sun
+		arcsec(450160.398036, -6962890.5431, 7.4722, 0.007702, -0.00005939),     // Ω, mean longitude of the Moon's ascending node
+		math.Mod(3.176146697+1021.3285546211*t, 2*math.Pi),                      // mean longitude of Venus
+		math.Mod(1.753470314+628.3075849991*t, 2*math.Pi),                       // mean longitude of the Earth
+		(0.024381750 + 0.00000538691*t) * t,                                     // general accumulated precession in longitude
+	}
+}
+
+func sumCT(terms []_CTTerm, fa [8]float64) float64 {
+	res := 0.0
+	for i := len(terms) - 1; i >= 0; i-- {
+		a := 0.0
+		for j, n := range terms[i].n {
+			a += n * fa[j]
+		}
+		res += terms[i].s*math.Sin(a) + terms[i].c*math.Cos(a)
+	}
+	return res
+}
+
+// Given a Julian Date, calculate complementary terms of the equation of the
+// equinoxes in arcseconds, according to the chosen model.
+//
+// Strictly, the argument should be Terrestrial Time; for these tiny terms
+// the difference between UT and TT is negligible.
+func Complementary(jd float64, model ComplementaryTerms) float64 {
+	t := (jd - julian.J2000) / julian.DAYS_PER_CENT
+	switch model {
+	case CTIAU1994:
+		om := fundamentalArgs(t)[4]
+		return 0.00264*math.Sin(om) + 0.000063*math.Sin(om+om)
+	case CTIAU2000:
+		fa := fundamentalArgs(t)
+		return sumCT(_CT0[:], fa) + sumCT(_CT1[:], fa)*t
+	}
+	return 0
+}
+
+// Equation of the equinoxes, difference between apparent and mean sidereal
+// time, in seconds of time.
+//
+// The Dpsi and Eps fields of options provide nutation in longitude and
+// obliquity of the ecliptic, unless Kind field requires otherwise;
+// Complementary field selects the complementary terms. JulianToSidereal
+// applies the same correction, so the value may be calculated separately
+// for logging:
+//
+//	opts := SiderealOptions{Dpsi: dpsi, Eps: eps, Complementary: CTIAU2000}
+//	ee := EquationOfEquinoxes(jd, opts)
+//	lst := JulianToSidereal(jd, opts)
+func EquationOfEquinoxes(jd float64, options SiderealOptions) float64 {
+	options = resolveNutation(jd, options)
+	dpsi := options.Dpsi * 3600 // degrees -> arcseconds
+	ee := dpsi*math.Cos(mathutils.Radians(options.Eps)) + Complementary(jd, options.Complementary)
+	return ee / 15.0 // arcseconds -> seconds of time
+}
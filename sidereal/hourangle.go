@@ -0,0 +1,100 @@
+package sidereal
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// Units of time intervals.
+type TimeUnits int
+
+const (
+	// Sidereal hours.
+	SiderealUnits TimeUnits = iota
+	// Solar (civil) hours.
+	SolarUnits
+)
+
+// Converts sidereal hours to the given units.
+func toUnits(hours float64, units TimeUnits) float64 {
+	if units == SolarUnits {
+		return hours / SOLAR_TO_SIDEREAL
+	}
+	return hours
+}
+
+// Given [jd], Julian Date and [ra], right ascension in hours, calculate hour
+// angle of a target in range -12 > x <= 12, negative eastwards of the meridian.
+// Options are the same as for JulianToSidereal; Lng field should contain
+// observer's longitude.
+//
+// In SolarUnits the result is time elapsed since the upper transit (or left
+// until it, if negative) in solar hours.
+func HourAngle(jd, ra float64, units TimeUnits, options SiderealOptions) float64 {
+	lst := JulianToSidereal(jd, options)
+	ha := -math.Remainder(ra-lst, 24)
+	if ha == -12 {
+		ha = 12
+	}
+	return toUnits(ha, units)
+}
+
+// Given [jd], Julian Date and [ra], right ascension in hours, calculate time
+// left until the next upper and lower transits of a target, in hours.
+func TimeToTransit(jd, ra float64, units TimeUnits, options SiderealOptions) (upper float64, lower float64) {
+	ha := HourAngle(jd, ra, SiderealUnits, options)
+	upper = mathutils.ReduceHours(-ha)
+	lower = mathutils.ReduceHours(12 - ha)
+	return toUnits(upper, units), toUnits(lower, units)
+}
+
+// Given [jd], Julian Date and [ra], right ascension in hours, calculate Julian
+// Dates of the next upper and lower transits of a target.
+func NextTransit(jd, ra float64, options SiderealOptions) (upper float64, lower float64) {
+	du, dl := TimeToTransit(jd, ra, SolarUnits, options)
+	upper = refineSidereal(jd+du/24, ra, options)
+	lower = refineSidereal(jd+dl/24, mathutils.ReduceHours(ra+12), options)
+	return upper, lower
+}
+
+// Given [jd], Julian Date, find the nearest instant at which sidereal time
+// equals [lst], looking forward.
+func nextSidereal(jd, lst float64, options SiderealOptions) float64 {
+	dt := mathutils.ReduceHours(lst-JulianToSidereal(jd, options)) * SIDEREAL_DAY / 24
+	return refineSidereal(jd+dt, lst, options)
+}
+
+// Given [start] and [end] of a time window as Julian Dates and [ra], right
+// ascension in hours, calculate how long a target spends on the eastern and
+// western side of the meridian, in hours.
+func MeridianSplit(start, end, ra float64, units TimeUnits, options SiderealOptions) (east float64, west float64) {
+	// first crossing of the meridian after start, upper or lower transit
+	c := nextSidereal(start, ra, options)
+	isUpper := true
+	if lower := nextSidereal(start, ra+12, options); lower < c {
+		c, isUpper = lower, false
+	}
+	// crossings follow each other every half of the sidereal day; each one
+	// is refined from the previous, so the steps can not become tiny
+	n := int((end-start)/(SIDEREAL_DAY/2)) + 2
+	t := start
+	for i := 0; i <= n && t < end; i++ {
+		next := math.Min(math.Max(c, t), end)
+		if isUpper {
+			// before the upper transit the target is east of the meridian
+			east += (next - t) * 24
+		} else {
+			west += (next - t) * 24
+		}
+		t = next
+		lst := ra
+		if isUpper {
+			lst = ra + 12
+		}
+		c = refineSidereal(c+SIDEREAL_DAY/2, lst, options)
+		isUpper = !isUpper
+	}
+	// intervals are measured in solar hours
+	return toUnits(east*SOLAR_TO_SIDEREAL, units), toUnits(west*SOLAR_TO_SIDEREAL, units)
+}
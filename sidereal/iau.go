@@ -0,0 +1,80 @@
+package sidereal
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// Model of the Greenwich Mean Sidereal Time.
+type SiderealModel int
+
+const (
+	// P.Duffett-Smith formula, based on UT date and fraction of the day.
+	ModelDuffettSmith SiderealModel = iota
+	// IAU 2000 resolutions, Earth Rotation Angle based.
+	ModelIAU2000
+	// IAU 2006 precession, Earth Rotation Angle based, IERS Conventions 2010.
+	ModelIAU2006
+)
+
+// Given [jd], UT1 Julian Date, calculate Earth Rotation Angle in arc-degrees.
+//
+// Source: IERS Conventions 2010, eq. 5.15.
+func EarthRotationAngle(jd float64) float64 {
+	// fractional part of the day is used separately for better precision
+	f := math.Mod(jd, 1.0)
+	tu := jd - julian.J2000
+	era := f + 0.7790572732640 + 0.00273781191135448*tu
+	return mathutils.Frac360(math.Mod(era, 1.0) + 1)
+}
+
+// Given polynomial part of GMST in arcseconds and ERA in degrees, return GMST in hours.
+func eraToGMST(era, poly float64) float64 {
+	return mathutils.ReduceHours((era + poly/3600) / 15)
+}
+
+// Given [ut1] and [tt], Julian Dates in UT1 and Terrestrial Time,
+// calculate Greenwich Mean Sidereal Time in hours, IAU 2000 model.
+func GMST2000(ut1, tt float64) float64 {
+	t := (tt - julian.J2000) / julian.DAYS_PER_CENT
+	poly := mathutils.Polynome(t, 0.014506, 4612.15739966, 1.39667721, -0.00009344, 0.00001882)
+	return eraToGMST(EarthRotationAngle(ut1), poly)
+}
+
+// Given [ut1] and [tt], Julian Dates in UT1 and Terrestrial Time,
+// calculate Greenwich Mean Sidereal Time in hours, IAU 2006 model.
+func GMST2006(ut1, tt float64) float64 {
+	t := (tt - julian.J2000) / julian.DAYS_PER_CENT
+	poly := mathutils.Polynome(t, 0.014506, 4612.156534, 1.3915817, -0.00000044, -0.000029956, -0.0000000368)
+	return eraToGMST(EarthRotationAngle(ut1), poly)
+}
+
+// Given [ut1] and [tt], Julian Dates in UT1 and Terrestrial Time, [dpsi],
+// nutation in longitude and [eps], obliquity of the ecliptic, both in degrees,
+// calculate Greenwich Apparent Sidereal Time in hours, IAU 2000 model.
+//
+// The equation of the equinoxes includes IAU 2000 complementary terms.
+// Accuracy of the result is limited by that of dpsi: with nutequ.Nutation,
+// which is good to about 1 arcsecond, the error is up to 0.07 second of time,
+// far from the microarcsecond level of IERS Conventions 2010 needed e.g. for
+// interferometry. For that, pass IAU 2000A/2006 nutation from another source.
+func GAST2000(ut1, tt, dpsi, eps float64) float64 {
+	ee := EquationOfEquinoxes(tt, SiderealOptions{Dpsi: dpsi, Eps: eps, Complementary: CTIAU2000})
+	return mathutils.ReduceHours(GMST2000(ut1, tt) + ee/3600)
+}
+
+// Given [ut1] and [tt], Julian Dates in UT1 and Terrestrial Time, [dpsi],
+// nutation in longitude and [eps], obliquity of the ecliptic, both in degrees,
+// calculate Greenwich Apparent Sidereal Time in hours, IAU 2006 model.
+//
+// The equation of the equinoxes includes IAU 2000 complementary terms.
+// Accuracy of the result is limited by that of dpsi: with nutequ.Nutation,
+// which is good to about 1 arcsecond, the error is up to 0.07 second of time,
+// far from the microarcsecond level of IERS Conventions 2010 needed e.g. for
+// interferometry. For that, pass IAU 2000A/2006 nutation from another source.
+func GAST2006(ut1, tt, dpsi, eps float64) float64 {
+	ee := EquationOfEquinoxes(tt, SiderealOptions{Dpsi: dpsi, Eps: eps, Complementary: CTIAU2000})
+	return mathutils.ReduceHours(GMST2006(ut1, tt) + ee/3600)
+}
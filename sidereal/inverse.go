@@ -0,0 +1,63 @@
+package sidereal
+
+import (
+	"context"
+	"math"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// Length of the sidereal day in solar days.
+const SIDEREAL_DAY = 1 / SOLAR_TO_SIDEREAL
+
+// Scan step and tolerance of SiderealToJulian, days
+const (
+	_SCAN_STEP      = 1.0 / 24
+	_SCAN_TOLERANCE = 1e-10
+)
+
+// Refines [jd] so that its sidereal time matches [lst].
+func refineSidereal(jd, lst float64, options SiderealOptions) float64 {
+	for i := 0; i < 3; i++ {
+		diff := math.Remainder(lst-JulianToSidereal(jd, options), 24)
+		jd += diff / SOLAR_TO_SIDEREAL / 24
+	}
+	return jd
+}
+
+// Given [lst], sidereal time in hours and a civil [date], find instants of
+// the date at which the sidereal time occurs. Options are the same as for
+// JulianToSidereal, e.g. Lng field makes lst Local Sidereal Time.
+//
+// Time part of the date is ignored, the search covers the whole UT day,
+// from 0h to 24h. Since the sidereal day is about 4 minutes shorter than
+// the solar day, some values of sidereal time occur twice within the day,
+// so the result contains one or two Julian Dates in ascending order. The
+// error comes from refinement of the instants, see mathutils.FindCrossings.
+//
+//	date := julian.CivilDate{Year: 2024, Month: 3, Day: 2}
+//	jds, err := SiderealToJulian(6.5, date, SiderealOptions{Lng: 37.5833})
+func SiderealToJulian(lst float64, date julian.CivilDate, options SiderealOptions) ([]float64, error) {
+	jd0 := julian.CivilToJulian(julian.CivilDate{Year: date.Year, Month: date.Month, Day: math.Floor(date.Day)})
+	jd1 := jd0 + 1
+
+	// sine of the difference increases through zero when the sidereal time
+	// passes lst and decreases 12 sidereal hours later
+	f := func(jd float64) float64 {
+		return math.Sin(mathutils.Radians((JulianToSidereal(jd, options) - lst) * 15))
+	}
+	// the scan starts a step earlier, not to miss an instant at 0h
+	scan := mathutils.Scan{Start: jd0 - _SCAN_STEP, End: jd1, Step: _SCAN_STEP, Tolerance: _SCAN_TOLERANCE}
+	events, err := mathutils.FindCrossings(context.Background(), f, 0, scan)
+	if err != nil {
+		return nil, err
+	}
+	var res []float64
+	for _, ev := range events {
+		if ev.Rising && ev.X >= jd0 && ev.X < jd1 {
+			res = append(res, ev.X)
+		}
+	}
+	return res, nil
+}
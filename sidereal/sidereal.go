@@ -8,14 +8,31 @@
 package sidereal
 
 import (
-	"math"
-
+	"github.com/skrushinsky/scaliger/deltat"
 	"github.com/skrushinsky/scaliger/julian"
 	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/nutequ"
 )
 
 const SOLAR_TO_SIDEREAL = 1.002737909350795
 
+// Type of the sidereal time.
+type SiderealKind int
+
+const (
+	// Apparent if Eps or Dpsi field is non-zero, mean otherwise; mean time
+	// ignores Complementary field.
+	KindInferred SiderealKind = iota
+	// Mean Sidereal Time, Eps, Dpsi and Complementary fields are ignored.
+	KindMean
+	// Apparent Sidereal Time, Eps and Dpsi fields are given by the caller
+	// and used as they are, even if both are zero.
+	KindApparent
+	// Apparent Sidereal Time, nutation and obliquity are calculated internally
+	// with Nutation and DeltaT models.
+	KindAutoApparent
+)
+
 // Controls type of the result.
 type SiderealOptions struct {
 	// geographical longitude, degrees, negative westwards
@@ -24,6 +41,54 @@ type SiderealOptions struct {
 	Eps float64
 	// nutation in longitude, degrees
 	Dpsi float64
+	// complementary terms of the equation of the equinoxes
+	Complementary ComplementaryTerms
+	// model of the mean sidereal time, Duffett-Smith by default
+	Model SiderealModel
+	// Delta-T model, seconds; deltat.DeltaT if nil
+	DeltaT func(jd float64) float64
+	// mean or apparent time, inferred from Eps and Dpsi by default
+	Kind SiderealKind
+	// nutation model for KindAutoApparent, degrees; nutequ.Nutation if nil
+	Nutation func(jd float64) (dpsi, deps float64)
+}
+
+// Given UT [jd], calculate Terrestrial Time with the Delta-T model from options.
+func terrestrial(jd float64, options SiderealOptions) float64 {
+	dt := options.DeltaT
+	if dt == nil {
+		dt = deltat.DeltaT
+	}
+	return jd + dt(jd)/julian.SEC_PER_DAY
+}
+
+// Returns options with Dpsi and Eps fields calculated with Nutation and
+// DeltaT models of the options.
+func autoNutation(jd float64, options SiderealOptions) SiderealOptions {
+	nut := options.Nutation
+	if nut == nil {
+		nut = nutequ.Nutation
+	}
+	tt := terrestrial(jd, options)
+	dpsi, deps := nut(tt)
+	options.Dpsi, options.Eps = dpsi, nutequ.TrueObliquity(tt, deps)
+	return options
+}
+
+// Returns options with Eps, Dpsi and Complementary fields set according to
+// Kind field.
+func resolveNutation(jd float64, options SiderealOptions) SiderealOptions {
+	switch options.Kind {
+	case KindInferred:
+		if options.Dpsi == 0 && options.Eps == 0 {
+			options.Complementary = CTNone
+		}
+	case KindMean:
+		options.Dpsi, options.Eps, options.Complementary = 0, 0, CTNone
+	case KindAutoApparent:
+		options = autoNutation(jd, options)
+	}
+	return options
 }
 
 func meanGMST(jd float64) float64 {
@@ -38,6 +103,18 @@ func meanGMST(jd float64) float64 {
 	return julian.ExtractUTC(jd)*SOLAR_TO_SIDEREAL + t0
 }
 
+// Greenwich Mean Sidereal Time according to the model chosen in options.
+func modelGMST(jd float64, options SiderealOptions) float64 {
+	if options.Model == ModelDuffettSmith {
+		return meanGMST(jd)
+	}
+	tt := terrestrial(jd, options)
+	if options.Model == ModelIAU2006 {
+		return GMST2006(jd, tt)
+	}
+	return GMST2000(jd, tt)
+}
+
 // Converts Julian date to Sidereal Time.
 // If options contain initialized Lng field, then the result is Local Sidereal Time.
 //
@@ -53,10 +130,28 @@ func meanGMST(jd float64) float64 {
 //	opts := SiderealOptions{Dpsi: -0.0043, Eps: 23.4443, Lng: 37.5833}
 //	lst := JulianToSidereal(jd, opts) // 23.0370...
 //
-// Otherwise, Mean Sidereal Time.
+// Otherwise, Mean Sidereal Time, and Complementary field is ignored.
+//
+// Kind field states the type of the result explicitly. KindMean ignores Eps
+// and Dpsi; KindApparent always uses them, even if they are zero. Only with
+// KindAutoApparent nutation and obliquity are calculated internally, using
+// Nutation and DeltaT models of the options (nutequ.Nutation and
+// deltat.DeltaT by default):
+//
+//	opts := SiderealOptions{Lng: 37.5833, Kind: KindAutoApparent}
+//	lst := JulianToSidereal(jd, opts)
+//
+// Model field selects the formula of the mean sidereal time. IAU models need
+// Terrestrial Time, which is obtained with DeltaT function, deltat.DeltaT by
+// default; jd is treated as UT1:
+//
+//	opts := SiderealOptions{Model: ModelIAU2006}
+//	gmst := JulianToSidereal(jd, opts)
+//
+// The correction for apparent time is the equation of the equinoxes, see
+// EquationOfEquinoxes.
 func JulianToSidereal(jd float64, options SiderealOptions) float64 {
-	dpsi := options.Dpsi * 3600                                       // degrees -> arcseconds
-	delta := (dpsi * math.Cos(mathutils.Radians(options.Eps))) / 15.0 // correction in seconds of time
+	delta := EquationOfEquinoxes(jd, options) // correction in seconds of time
 	lng := options.Lng / 15
-	return mathutils.ReduceHours(meanGMST(jd) + delta/3600 + lng)
+	return mathutils.ReduceHours(modelGMST(jd, options) + delta/3600 + lng)
 }
@@ -1,8 +1,11 @@
 package sidereal
 
 import (
+	"math"
 	"testing"
 
+	"github.com/skrushinsky/scaliger/deltat"
+	"github.com/skrushinsky/scaliger/julian"
 	"github.com/skrushinsky/scaliger/mathutils"
 	"github.com/skrushinsky/scaliger/nutequ"
 )
@@ -59,3 +62,278 @@ func TestTrueSiderealSwissEphemeris(t *testing.T) {
 		t.Errorf("Expected: %f, got: %f", exp, lst)
 	}
 }
+
+func TestComplementaryIAU2000(t *testing.T) {
+	// SOFA eect00 test case: 0.2046085004885125264e-8 radians
+	got := Complementary(2453736.5, CTIAU2000)
+	exp := 0.2046085004885125264e-8 * 206264.80624709636
+	if !mathutils.AlmostEqual(got, exp, 1e-9) {
+		t.Errorf("Expected: %e, got: %e", exp, got)
+	}
+}
+
+func TestComplementaryIAU1994(t *testing.T) {
+	got := Complementary(2453736.5, CTIAU1994)
+	exp := Complementary(2453736.5, CTIAU2000)
+	if !mathutils.AlmostEqual(got, exp, 2e-5) {
+		t.Errorf("Expected: %e, got: %e", exp, got)
+	}
+}
+
+func TestEquationOfEquinoxes(t *testing.T) {
+	jd := 2438792.990277778
+	dpsi, deps := nutequ.Nutation(jd)
+	eps := nutequ.TrueObliquity(jd, deps)
+	opts := SiderealOptions{Dpsi: dpsi, Eps: eps, Lng: 37.583333333333336, Complementary: CTIAU2000}
+	ee := EquationOfEquinoxes(jd, opts)
+	exp := -0.9424 // seconds of time
+	if !mathutils.AlmostEqual(ee, exp, 1e-3) {
+		t.Errorf("Expected: %f, got: %f", exp, ee)
+	}
+	mean := JulianToSidereal(jd, SiderealOptions{Lng: opts.Lng})
+	lst := JulianToSidereal(jd, opts)
+	if !mathutils.AlmostEqual(lst-mean, ee/3600, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", ee/3600, lst-mean)
+	}
+}
+
+const _RAD_HOURS = 12 / math.Pi
+
+func TestEarthRotationAngle(t *testing.T) {
+	// SOFA era00 test case
+	got := EarthRotationAngle(2400000.5 + 54388.0)
+	exp := mathutils.Degrees(0.4022837240028158102)
+	if !mathutils.AlmostEqual(got, exp, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+}
+
+func TestGMST2000(t *testing.T) {
+	// SOFA gmst00 test case
+	jd := 2400000.5 + 53736.0
+	got := GMST2000(jd, jd)
+	exp := 1.754174972210740592 * _RAD_HOURS
+	if !mathutils.AlmostEqual(got, exp, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+}
+
+func TestGMST2006(t *testing.T) {
+	// SOFA gmst06 test case
+	jd := 2400000.5 + 53736.0
+	got := GMST2006(jd, jd)
+	exp := 1.754174971870091203 * _RAD_HOURS
+	if !mathutils.AlmostEqual(got, exp, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+}
+
+func TestGAST2006(t *testing.T) {
+	// SOFA gst06a test case; our nutation is accurate to about 1 arcsecond
+	jd := 2400000.5 + 53736.0
+	dpsi, deps := nutequ.Nutation(jd)
+	eps := nutequ.TrueObliquity(jd, deps)
+	got := GAST2006(jd, jd, dpsi, eps)
+	exp := 1.754166137675019159 * _RAD_HOURS
+	if !mathutils.AlmostEqual(got, exp, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+}
+
+func TestSiderealModels(t *testing.T) {
+	// IAU models should agree with the legacy formula within a fraction of a second
+	for _, test := range cases {
+		legacy := JulianToSidereal(test.jd, SiderealOptions{})
+		for _, model := range []SiderealModel{ModelIAU2000, ModelIAU2006} {
+			got := JulianToSidereal(test.jd, SiderealOptions{Model: model})
+			if !mathutils.AlmostEqual(got, legacy, 1e-4) {
+				t.Errorf("Model %d, expected: %f, got: %f", model, legacy, got)
+			}
+		}
+	}
+}
+
+func TestSiderealToJulian(t *testing.T) {
+	date := julian.CivilDate{Year: 1984, Month: 8, Day: 31.4}
+	jds, err := SiderealToJulian(7.072111, date, SiderealOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jds) != 1 {
+		t.Fatalf("Expected 1 instant, got: %d", len(jds))
+	}
+	exp := 2445943.851053
+	if !mathutils.AlmostEqual(jds[0], exp, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", exp, jds[0])
+	}
+}
+
+func TestSiderealToJulianTwice(t *testing.T) {
+	date := julian.CivilDate{Year: 2024, Month: 3, Day: 2}
+	opts := SiderealOptions{Lng: 37.5833}
+	s0 := JulianToSidereal(julian.CivilToJulian(date), opts)
+	// sidereal time slightly after the one at midnight occurs twice
+	lst := mathutils.ReduceHours(s0 + 0.01)
+	jds, err := SiderealToJulian(lst, date, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jds) != 2 {
+		t.Fatalf("Expected 2 instants, got: %d", len(jds))
+	}
+	if !mathutils.AlmostEqual(jds[1]-jds[0], SIDEREAL_DAY, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", SIDEREAL_DAY, jds[1]-jds[0])
+	}
+	for _, jd := range jds {
+		got := JulianToSidereal(jd, opts)
+		if !mathutils.AlmostEqual(got, lst, 1e-7) {
+			t.Errorf("Expected: %f, got: %f", lst, got)
+		}
+	}
+}
+
+func TestAutoApparentSidereal(t *testing.T) {
+	jd := 2438792.990277778
+	dt := deltat.DeltaT(jd)
+	jde := jd + dt/86400
+	dpsi, deps := nutequ.Nutation(jde)
+	eps := nutequ.TrueObliquity(jde, deps)
+	exp := JulianToSidereal(jd, SiderealOptions{Dpsi: dpsi, Eps: eps, Lng: 37.583333333333336})
+	got := JulianToSidereal(jd, SiderealOptions{Lng: 37.583333333333336, Kind: KindAutoApparent})
+	if !mathutils.AlmostEqual(got, exp, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+}
+
+func TestExplicitMeanSidereal(t *testing.T) {
+	for _, test := range cases {
+		opts := SiderealOptions{Dpsi: -0.0043, Eps: 23.4443, Kind: KindMean}
+		lst := JulianToSidereal(test.jd, opts)
+		if !mathutils.AlmostEqual(lst, test.lst, 1e-4) {
+			t.Errorf("Expected: %f, got: %f", test.lst, lst)
+		}
+		if ee := EquationOfEquinoxes(test.jd, opts); ee != 0 {
+			t.Errorf("Expected zero equation of the equinoxes, got: %f", ee)
+		}
+	}
+}
+
+func TestCustomNutationModel(t *testing.T) {
+	jd := 2446896.30625
+	opts := SiderealOptions{
+		Kind:     KindAutoApparent,
+		DeltaT:   func(float64) float64 { return 0 },
+		Nutation: func(float64) (float64, float64) { return -3.788 / 3600, 0 },
+	}
+	ee := EquationOfEquinoxes(jd, opts)
+	exp := -3.788 * math.Cos(mathutils.Radians(nutequ.MeanObliquity(jd))) / 15
+	if !mathutils.AlmostEqual(ee, exp, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", exp, ee)
+	}
+}
+
+func TestHourAngle(t *testing.T) {
+	jd := 2445943.851053 // LST = 7.072111
+	got := HourAngle(jd, 5.072111, SiderealUnits, SiderealOptions{})
+	if !mathutils.AlmostEqual(got, 2, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", 2.0, got)
+	}
+	got = HourAngle(jd, 9.072111, SolarUnits, SiderealOptions{})
+	exp := -2 / SOLAR_TO_SIDEREAL
+	if !mathutils.AlmostEqual(got, exp, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", exp, got)
+	}
+}
+
+func TestNextTransit(t *testing.T) {
+	opts := SiderealOptions{Lng: 37.5833, Kind: KindAutoApparent}
+	jd := 2460372.5
+	ra := 10.5
+	upper, lower := NextTransit(jd, ra, opts)
+	if upper <= jd || upper > jd+1 || lower <= jd || lower > jd+1 {
+		t.Fatalf("Transits out of range: %f, %f", upper, lower)
+	}
+	if got := HourAngle(upper, ra, SiderealUnits, opts); !mathutils.AlmostEqual(got, 0, 1e-7) {
+		t.Errorf("Expected upper transit, got hour angle: %f", got)
+	}
+	if got := HourAngle(lower, ra, SiderealUnits, opts); !mathutils.AlmostEqual(math.Abs(got), 12, 1e-7) {
+		t.Errorf("Expected lower transit, got hour angle: %f", got)
+	}
+	du, _ := TimeToTransit(jd, ra, SolarUnits, opts)
+	if !mathutils.AlmostEqual(jd+du/24, upper, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", upper, jd+du/24)
+	}
+}
+
+func TestMeridianSplit(t *testing.T) {
+	opts := SiderealOptions{Lng: 37.5833}
+	jd := 2460372.5
+	ra := 10.5
+	upper, _ := NextTransit(jd, ra, opts)
+	east, west := MeridianSplit(upper-0.125, upper+0.25, ra, SolarUnits, opts)
+	if !mathutils.AlmostEqual(east, 3, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", 3.0, east)
+	}
+	if !mathutils.AlmostEqual(west, 6, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", 6.0, west)
+	}
+	// whole sidereal day is split evenly
+	east, west = MeridianSplit(jd, jd+SIDEREAL_DAY, ra, SiderealUnits, opts)
+	if !mathutils.AlmostEqual(east, 12, 1e-5) || !mathutils.AlmostEqual(west, 12, 1e-5) {
+		t.Errorf("Expected: 12, 12, got: %f, %f", east, west)
+	}
+}
+
+func TestSiderealKinds(t *testing.T) {
+	jd := 2438792.990277778
+	opts := SiderealOptions{Lng: 37.583333333333336, Complementary: CTIAU2000}
+	mean := JulianToSidereal(jd, SiderealOptions{Lng: opts.Lng, Kind: KindMean})
+
+	// no nutation given: inferred time is purely mean
+	opts.Kind = KindInferred
+	if got := JulianToSidereal(jd, opts); got != mean {
+		t.Errorf("Expected: %f, got: %f", mean, got)
+	}
+	// apparent time uses zero nutation as it is, only complementary terms
+	// are added
+	opts.Kind = KindApparent
+	got := JulianToSidereal(jd, opts)
+	exp := mean + Complementary(jd, CTIAU2000)/15/3600
+	if !mathutils.AlmostEqual(got, exp, 1e-12) {
+		t.Errorf("Expected: %.10f, got: %.10f", exp, got)
+	}
+	// automatic apparent time calculates nutation itself
+	opts.Kind = KindAutoApparent
+	auto := JulianToSidereal(jd, opts)
+	if mathutils.AlmostEqual(auto, got, 1e-5) {
+		t.Errorf("Expected automatic apparent time to differ from %f, got: %f", got, auto)
+	}
+	// given nutation is used as it is
+	opts.Kind = KindApparent
+	opts.Dpsi, opts.Eps = -0.0043, 23.4443
+	given := JulianToSidereal(jd, opts)
+	opts.Kind = KindInferred
+	if got := JulianToSidereal(jd, opts); got != given {
+		t.Errorf("Expected: %f, got: %f", given, got)
+	}
+}
+
+func TestMeridianSplitNearMeridian(t *testing.T) {
+	// these windows start with the target at the meridian
+	cases := []struct {
+		start, end, ra float64
+		opts           SiderealOptions
+	}{
+		{start: 2460037.7499414254, end: 2460038.2194991894, ra: 16.483753748810628,
+			opts: SiderealOptions{Lng: -156.37067308170856, Kind: KindInferred}},
+		{start: 2453484.39037829, end: 2453486.831298173, ra: 7.22188465404689,
+			opts: SiderealOptions{Lng: 5.4765462607435325, Kind: KindAutoApparent}},
+	}
+	for _, c := range cases {
+		east, west := MeridianSplit(c.start, c.end, c.ra, SolarUnits, c.opts)
+		exp := (c.end - c.start) * 24
+		if got := east + west; !mathutils.AlmostEqual(got, exp, 1e-6) {
+			t.Errorf("Expected: %f, got: %f", exp, got)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Converts Universal Time into local mean and apparent (sundial) solar time
+// and back.
+//
+// Local Mean Time differs from Universal Time by the observer's longitude,
+// 4 minutes of time per degree. Apparent Solar Time is reckoned by the true
+// Sun; it differs from the mean time by the equation of time, which never
+// exceeds 17 minutes.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, chapter 28.
+package solartime
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/deltat"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/nutequ"
+)
+
+// Minutes per day
+const _MIN_PER_DAY = 24 * 60
+
+// Given [jd], UT Julian Date, calculate equation of time in minutes.
+//
+// Equation of time is the difference between apparent and mean solar time;
+// positive value means that a sundial is ahead of the clock.
+func EquationOfTime(jd float64) float64 {
+	jde := jd + deltat.DeltaT(jd)/julian.SEC_PER_DAY // Dynamic time.
+	t := (jde - julian.J2000) / julian.DAYS_PER_CENT
+
+	l0 := mathutils.Radians(mathutils.ReduceDeg(mathutils.Polynome(t, 280.46646, 36000.76983, 0.0003032))) // Sun's mean longitude
+	m := mathutils.Radians(mathutils.ReduceDeg(mathutils.Polynome(t, 357.52911, 35999.05029, -0.0001537))) // Sun's mean anomaly
+	e := mathutils.Polynome(t, 0.016708634, -0.000042037, -0.0000001267)                                   // eccentricity of the Earth's orbit
+
+	_, deps := nutequ.Nutation(jde)
+	eps := mathutils.Radians(nutequ.TrueObliquity(jde, deps))
+	y := math.Tan(eps / 2)
+	y *= y
+
+	sin2l0 := math.Sin(2 * l0)
+	sinm := math.Sin(m)
+	eot := y*sin2l0 -
+		2*e*sinm +
+		4*e*y*sinm*math.Cos(2*l0) -
+		0.5*y*y*math.Sin(4*l0) -
+		1.25*e*e*math.Sin(2*m)
+
+	return mathutils.Degrees(eot) * 4 // degrees -> minutes of time
+}
+
+// Given [jd], UT Julian Date and [lng], geographical longitude in degrees,
+// negative westwards, calculate Local Mean Time as Julian Date.
+//
+// Use julian.JulianToCivil to obtain local date and time from the result.
+func UniversalToMean(jd, lng float64) float64 {
+	return jd + lng/360
+}
+
+// Given [lmt], Local Mean Time as Julian Date and [lng], geographical
+// longitude in degrees, negative westwards, calculate UT Julian Date.
+func MeanToUniversal(lmt, lng float64) float64 {
+	return lmt - lng/360
+}
+
+// Given [jd], UT Julian Date and [lng], geographical longitude in degrees,
+// negative westwards, calculate Local Apparent (sundial) Time as Julian Date.
+func UniversalToApparent(jd, lng float64) float64 {
+	return UniversalToMean(jd, lng) + EquationOfTime(jd)/_MIN_PER_DAY
+}
+
+// Given [last], Local Apparent (sundial) Solar Time as Julian Date and [lng],
+// geographical longitude in degrees, negative westwards, calculate UT Julian
+// Date.
+//
+// Equation of time depends on UT, so the result is found by iterations.
+func ApparentToUniversal(last, lng float64) float64 {
+	jd := MeanToUniversal(last, lng)
+	for i := 0; i < 3; i++ {
+		jd = MeanToUniversal(last-EquationOfTime(jd)/_MIN_PER_DAY, lng)
+	}
+	return jd
+}
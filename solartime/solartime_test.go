@@ -0,0 +1,51 @@
+package solartime
+
+import (
+	"testing"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+type _EotTestCase struct {
+	jd  float64
+	eot float64
+}
+
+var eotCases = [...]_EotTestCase{
+	{jd: 2448908.5, eot: 13.7117}, // 1992 Oct 13, Meeus, example 28.b
+	{jd: julian.CivilToJulian(julian.CivilDate{Year: 2024, Month: 2, Day: 11.5}), eot: -14.22}, // near the minimum
+	{jd: julian.CivilToJulian(julian.CivilDate{Year: 2024, Month: 11, Day: 3.5}), eot: 16.47},  // near the maximum
+}
+
+func TestEquationOfTime(t *testing.T) {
+	for _, test := range eotCases {
+		got := EquationOfTime(test.jd)
+		if !mathutils.AlmostEqual(got, test.eot, 0.05) {
+			t.Errorf("Expected: %f, got: %f", test.eot, got)
+		}
+	}
+}
+
+func TestMeanTime(t *testing.T) {
+	jd := 2460372.5
+	lmt := UniversalToMean(jd, -75)
+	if !mathutils.AlmostEqual(lmt, jd-5.0/24, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", jd-5.0/24, lmt)
+	}
+	if got := MeanToUniversal(lmt, -75); !mathutils.AlmostEqual(got, jd, 1e-9) {
+		t.Errorf("Expected: %f, got: %f", jd, got)
+	}
+}
+
+func TestApparentTime(t *testing.T) {
+	jd := 2448908.5
+	last := UniversalToApparent(jd, 37.5833)
+	exp := jd + 37.5833/360 + 13.7117/1440
+	if !mathutils.AlmostEqual(last, exp, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", exp, last)
+	}
+	if got := ApparentToUniversal(last, 37.5833); !mathutils.AlmostEqual(got, jd, 1e-8) {
+		t.Errorf("Expected: %f, got: %f", jd, got)
+	}
+}
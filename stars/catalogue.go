@@ -0,0 +1,151 @@
+package stars
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// Reads catalogue in CSV format. The first record is a header, which
+// names the columns; their order is arbitrary, names are case-insensitive.
+//
+//	name      star name
+//	ra        right ascension, hours, sexagesimal or decimal; "d" suffix
+//	          for arc-degrees, e.g. "279.2347d"
+//	dec       declination, arc-degrees, sexagesimal or decimal
+//	pmra      proper motion in right ascension, μα·cos δ, mas/yr
+//	pmdec     proper motion in declination, mas/yr
+//	parallax  parallax, mas
+//	rv        radial velocity, km/s
+//	epoch     Julian epoch with "J" prefix, e.g. "J1991.25", or Julian Date
+//	mag       visual magnitude
+//
+// Only ra and dec columns are required. Empty fields are zero, except
+// epoch, which is J2000 by default. Lines starting with "#" are comments.
+//
+//	name,ra,dec,pmra,pmdec,parallax,rv,epoch
+//	Barnard's Star,17 57 48.97,+04 40 05.8,-797.84,10326.93,548.31,-110.51,J1991.25
+func ReadCatalogue(r io.Reader) ([]Star, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("catalogue: empty input")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("catalogue: %w", err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"ra", "dec"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("catalogue: missing %q column", name)
+		}
+	}
+
+	var res []Star
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("catalogue: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		star, err := parseStar(record, columns)
+		if err != nil {
+			return nil, fmt.Errorf("catalogue: line %d: %w", line, err)
+		}
+		res = append(res, star)
+	}
+}
+
+// Reads catalogue from CSV file, see ReadCatalogue.
+func LoadCatalogue(path string) ([]Star, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadCatalogue(f)
+}
+
+func parseStar(record []string, columns map[string]int) (Star, error) {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	number := func(name string) (float64, error) {
+		s := field(name)
+		if s == "" {
+			return 0, nil
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s %q", name, s)
+		}
+		return v, nil
+	}
+
+	star := Star{Name: field("name"), Epoch: julian.J2000}
+	ra, err := mathutils.ParseHours(field("ra"))
+	if err != nil {
+		return star, err
+	}
+	dec, err := mathutils.ParseAngle(field("dec"))
+	if err != nil {
+		return star, err
+	}
+	star.RA, star.Dec = ra.Reduce().Degrees(), dec.Degrees()
+
+	for _, f := range []struct {
+		name string
+		dst  *float64
+	}{
+		{"pmra", &star.PMRA},
+		{"pmdec", &star.PMDec},
+		{"parallax", &star.Parallax},
+		{"rv", &star.RV},
+		{"mag", &star.Mag},
+	} {
+		if *f.dst, err = number(f.name); err != nil {
+			return star, err
+		}
+	}
+
+	if s := field("epoch"); s != "" {
+		if star.Epoch, err = parseEpoch(s); err != nil {
+			return star, err
+		}
+	}
+	return star, nil
+}
+
+// Parses Julian epoch, "J1991.25", or Julian Date.
+func parseEpoch(s string) (float64, error) {
+	if rest, ok := strings.CutPrefix(strings.ToUpper(s), "J"); ok {
+		y, err := strconv.ParseFloat(rest, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid epoch %q", s)
+		}
+		return julian.JulianEpochToJulian(y), nil
+	}
+	jd, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid epoch %q", s)
+	}
+	return jd, nil
+}
@@ -0,0 +1,64 @@
+package stars
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+const _CATALOGUE = `# bright stars
+Name,RA,Dec,Mag,PMRA,PMDec,Parallax,RV,Epoch
+Vega,18 36 56.336,+38 47 01.28,0.03,200.94,286.23,130.23,-13.5,J2000
+Barnard's Star,269.45402305d,4.66828815,9.5,-797.84,10326.93,548.31,-110.51,J1991.25
+Polaris,02:31:49.09,+89:15:50.8,1.98,,,,,
+`
+
+func TestReadCatalogue(t *testing.T) {
+	cat, err := ReadCatalogue(strings.NewReader(_CATALOGUE))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cat) != 3 {
+		t.Fatalf("Expected: %d, got: %d", 3, len(cat))
+	}
+	vega := cat[0]
+	if vega.Name != "Vega" {
+		t.Errorf("Expected: %s, got: %s", "Vega", vega.Name)
+	}
+	if !mathutils.AlmostEqual(vega.RA, 279.23473333, 1e-7) {
+		t.Errorf("Expected: %f, got: %f", 279.23473333, vega.RA)
+	}
+	if !mathutils.AlmostEqual(vega.Dec, 38.78368889, 1e-7) {
+		t.Errorf("Expected: %f, got: %f", 38.78368889, vega.Dec)
+	}
+	if vega.Mag != 0.03 || vega.Parallax != 130.23 || vega.RV != -13.5 {
+		t.Errorf("Unexpected values: %+v", vega)
+	}
+	if cat[1].RA != 269.45402305 || cat[1].Epoch != EPOCH_HIPPARCOS {
+		t.Errorf("Expected: %f, %f, got: %f, %f", 269.45402305, EPOCH_HIPPARCOS, cat[1].RA, cat[1].Epoch)
+	}
+	if cat[2].Epoch != julian.J2000 || cat[2].Parallax != 0 {
+		t.Errorf("Expected defaults, got: %+v", cat[2])
+	}
+}
+
+func TestReadCatalogueErrors(t *testing.T) {
+	cases := []struct {
+		input string
+		err   string
+	}{
+		{input: "", err: "empty input"},
+		{input: "name,ra\nVega,18 36 56\n", err: `missing "dec" column`},
+		{input: "name,ra,dec\nVega,18 36 56,+38 47 01\nBad,25x,0\n", err: "line 3"},
+		{input: "ra,dec,rv\n1,2,fast\n", err: `invalid rv "fast"`},
+		{input: "ra,dec,epoch\n1,2,B1950\n", err: `invalid epoch "B1950"`},
+	}
+	for _, test := range cases {
+		_, err := ReadCatalogue(strings.NewReader(test.input))
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("Expected error containing %q, got: %v", test.err, err)
+		}
+	}
+}
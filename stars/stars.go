@@ -0,0 +1,151 @@
+// Positions of stars from catalogue data: coordinates, proper motion,
+// parallax and radial velocity at a catalogue epoch.
+//
+// Catalogue positions are propagated to another epoch by rigorous linear
+// space motion, which takes perspective acceleration into account. The
+// result is then reduced to apparent and observed places with apparent
+// package.
+//
+// Source: "The Hipparcos and Tycho Catalogues", ESA SP-1200, 1997,
+// vol. 1, section 1.5.5.
+package stars
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/apparent"
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/deltat"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/nutequ"
+	"github.com/skrushinsky/scaliger/vsop87"
+)
+
+// Catalogue epochs, Julian Dates (TT).
+const (
+	// Hipparcos, J1991.25
+	EPOCH_HIPPARCOS = 2448349.0625
+	// Gaia DR3, J2016.0
+	EPOCH_GAIA_DR3 = 2457389.0
+)
+
+// One parsec per Julian year, km/s.
+const _PC_PER_YEAR = 977792.2
+
+// Milliarcseconds per radian.
+const _MAS_PER_RAD = 180 / math.Pi * 3600e3
+
+// Catalogue entry. Coordinates refer to the ICRS, which is within a few
+// milliarcseconds of the mean equator and equinox of J2000.
+type Star struct {
+	Name string
+	// right ascension, arc-degrees
+	RA float64
+	// declination, arc-degrees
+	Dec float64
+	// proper motion in right ascension, μα·cos δ, milliarcseconds per year
+	PMRA float64
+	// proper motion in declination, milliarcseconds per year
+	PMDec float64
+	// parallax, milliarcseconds; zero if unknown
+	Parallax float64
+	// radial velocity, km/s, positive for receding stars
+	RV float64
+	// epoch of the coordinates, Julian Date (TT)
+	Epoch float64
+	// visual magnitude
+	Mag float64
+}
+
+// Unit vectors of the local triad: towards the star, towards increasing
+// right ascension and towards increasing declination.
+func triad(ra, dec float64) (u, p, q coords.Cartesian) {
+	sa, ca := math.Sincos(mathutils.Radians(ra))
+	sd, cd := math.Sincos(mathutils.Radians(dec))
+	u = coords.Cartesian{X: cd * ca, Y: cd * sa, Z: sd}
+	p = coords.Cartesian{X: -sa, Y: ca, Z: 0}
+	q = coords.Cartesian{X: -sd * ca, Y: -sd * sa, Z: cd}
+	return
+}
+
+func dot(a, b coords.Cartesian) float64 {
+	return a.X*b.X + a.Y*b.Y + a.Z*b.Z
+}
+
+// Given [jde], Julian Ephemeris Date, calculate the catalogue entry for
+// that epoch: coordinates, proper motion, parallax and radial velocity,
+// changed by the space motion of the star. When parallax is unknown,
+// the star moves along a great circle with constant proper motion.
+//
+// Light-time within the stellar system is neglected.
+func (s Star) Propagate(jde float64) Star {
+	t := (jde - s.Epoch) / julian.DAYS_PER_YEAR
+	if t == 0 {
+		return s
+	}
+	u, p, q := triad(s.RA, s.Dec)
+	// distance, pc, and velocity, pc per year
+	d, vr := 1.0, 0.0
+	if s.Parallax > 0 {
+		d = 1000 / s.Parallax
+		vr = s.RV / _PC_PER_YEAR
+	}
+	pm := func(mas float64) float64 { return mas / _MAS_PER_RAD * d }
+	v := coords.Cartesian{
+		X: pm(s.PMRA)*p.X + pm(s.PMDec)*q.X + vr*u.X,
+		Y: pm(s.PMRA)*p.Y + pm(s.PMDec)*q.Y + vr*u.Y,
+		Z: pm(s.PMRA)*p.Z + pm(s.PMDec)*q.Z + vr*u.Z,
+	}
+	r := coords.Cartesian{X: d*u.X + v.X*t, Y: d*u.Y + v.Y*t, Z: d*u.Z + v.Z*t}
+	sph := r.Spherical()
+
+	res := s
+	res.RA, res.Dec, res.Epoch = sph.Lon, sph.Lat, jde
+	u, p, q = triad(res.RA, res.Dec)
+	res.PMRA = dot(v, p) / sph.R * _MAS_PER_RAD
+	res.PMDec = dot(v, q) / sph.R * _MAS_PER_RAD
+	if s.Parallax > 0 {
+		res.Parallax = 1000 / sph.R
+		res.RV = dot(v, u) * _PC_PER_YEAR
+	}
+	return res
+}
+
+// Heliocentric position of the Earth, AU, equatorial J2000 frame.
+func earthJ2000(jde float64) coords.Cartesian {
+	l, b, r := vsop87.Earth.Heliocentric(jde)
+	ecl := coords.Spherical{Lon: l, Lat: b, R: r}.Cartesian()
+	m := coords.PrecessionMatrix(jde, julian.J2000).Mul(coords.EclipticToEquatorialMatrix(nutequ.MeanObliquity(jde)))
+	return m.Apply(ecl)
+}
+
+// Given [jde], calculate geocentric astrometric position of the star,
+// arc-degrees: catalogue position, propagated to the date and corrected
+// for annual parallax, referred to the mean equator and equinox of J2000.
+func (s Star) Astrometric(jde float64) (ra float64, dec float64) {
+	st := s.Propagate(jde)
+	if st.Parallax <= 0 {
+		return st.RA, st.Dec
+	}
+	u, _, _ := triad(st.RA, st.Dec)
+	e := earthJ2000(jde)
+	k := st.Parallax / _MAS_PER_RAD
+	sph := coords.Cartesian{X: u.X - k*e.X, Y: u.Y - k*e.Y, Z: u.Z - k*e.Z}.Spherical()
+	return sph.Lon, sph.Lat
+}
+
+// Given [jde], calculate geocentric apparent position of the star,
+// referred to the true equator and equinox of the date, arc-degrees.
+func (s Star) Apparent(jde float64) (ra float64, dec float64) {
+	ra, dec = s.Astrometric(jde)
+	return apparent.Geocentric(ra, dec, jde)
+}
+
+// Given [jd], UT Julian Date, [obs], observer's position and [weather],
+// calculate topocentric apparent place and observed horizontal
+// coordinates of the star.
+func (s Star) Observe(jd float64, obs apparent.Observer, weather apparent.Weather) apparent.Place {
+	ra, dec := s.Astrometric(jd + deltat.DeltaT(jd)/julian.SEC_PER_DAY)
+	return apparent.Observe(ra, dec, jd, obs, weather)
+}
@@ -0,0 +1,112 @@
+package stars
+
+import (
+	"math"
+	"testing"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// One arc-second, in degrees
+const _ARCSEC = 1.0 / 3600
+
+// Barnard's Star, Hipparcos catalogue
+var barnard = Star{
+	Name:     "Barnard's Star",
+	RA:       269.45402305,
+	Dec:      4.66828815,
+	PMRA:     -797.84,
+	PMDec:    10326.93,
+	Parallax: 548.31,
+	RV:       -110.51,
+	Epoch:    EPOCH_HIPPARCOS,
+}
+
+func TestPropagate(t *testing.T) {
+	// SIMBAD, ICRS, J2000: 17h57m48.498s +04°41'36.21"
+	got := barnard.Propagate(julian.J2000)
+	ra := mathutils.AngleFromHMS(false, 17, 57, 48.498).Degrees()
+	dec := mathutils.AngleFromDMS(false, 4, 41, 36.21).Degrees()
+	if !mathutils.AlmostEqual(got.RA, ra, 0.1*_ARCSEC) {
+		t.Errorf("Expected: %f, got: %f", ra, got.RA)
+	}
+	if !mathutils.AlmostEqual(got.Dec, dec, 0.1*_ARCSEC) {
+		t.Errorf("Expected: %f, got: %f", dec, got.Dec)
+	}
+}
+
+func TestPerspectiveAcceleration(t *testing.T) {
+	// secular acceleration of Barnard's Star is about 1.3 mas/yr²
+	got := barnard.Propagate(julian.JulianEpochToJulian(2001.25))
+	acc := (got.PMDec - barnard.PMDec) / 10
+	if !mathutils.AlmostEqual(acc, 1.28, 0.02) {
+		t.Errorf("Expected: %f, got: %f", 1.28, acc)
+	}
+	if got.Parallax <= barnard.Parallax || got.RV <= barnard.RV {
+		t.Errorf("Expected approaching star, got parallax %f, RV %f", got.Parallax, got.RV)
+	}
+}
+
+func TestClosestApproach(t *testing.T) {
+	// Barnard's Star will pass at 3.75 light-years about AD 11,700
+	best, year := 0.0, 0.0
+	for y := 2000.0; y < 20000; y += 10 {
+		st := barnard.Propagate(julian.JulianEpochToJulian(y))
+		if st.Parallax > best {
+			best, year = st.Parallax, y
+		}
+	}
+	if !mathutils.AlmostEqual(best, 871, 2) {
+		t.Errorf("Expected: %f, got: %f", 871.0, best)
+	}
+	if !mathutils.AlmostEqual(year, 11730, 50) {
+		t.Errorf("Expected: %f, got: %f", 11730.0, year)
+	}
+}
+
+func TestPropagateWithoutParallax(t *testing.T) {
+	star := barnard
+	star.Parallax = 0
+	got := star.Propagate(julian.JulianEpochToJulian(2001.25))
+	if !mathutils.AlmostEqual(got.PMDec, star.PMDec, 0.01) {
+		t.Errorf("Expected: %f, got: %f", star.PMDec, got.PMDec)
+	}
+	if got.RV != star.RV || got.Parallax != 0 {
+		t.Errorf("Expected unchanged RV and parallax, got: %f, %f", got.RV, got.Parallax)
+	}
+}
+
+func TestAnnualParallax(t *testing.T) {
+	// the star describes an ellipse with semi-major axis equal to parallax
+	max := 0.0
+	for d := 0.0; d < 366; d += 5 {
+		jde := julian.J2000 + d
+		ra, dec := barnard.Astrometric(jde)
+		st := barnard.Propagate(jde)
+		dra := (ra - st.RA) * math.Cos(mathutils.Radians(dec))
+		max = math.Max(max, math.Hypot(dra, dec-st.Dec)*3600e3)
+	}
+	if !mathutils.AlmostEqual(max, barnard.Parallax, 15) {
+		t.Errorf("Expected: %f, got: %f", barnard.Parallax, max)
+	}
+}
+
+func TestApparent(t *testing.T) {
+	// Meeus, examples 21.b and 23.a, θ Persei, 2028 Nov 13.19 TD
+	star := Star{
+		Name:  "θ Persei",
+		RA:    mathutils.AngleFromHMS(false, 2, 44, 11.986).Degrees(),
+		Dec:   mathutils.AngleFromDMS(false, 49, 13, 42.48).Degrees(),
+		PMRA:  0.03425 * 15e3 * math.Cos(mathutils.Radians(49.228467)),
+		PMDec: -89.5,
+		Epoch: julian.J2000,
+	}
+	ra, dec := star.Apparent(2462088.69)
+	if !mathutils.AlmostEqual(ra, 41.5599646, 0.5*_ARCSEC) {
+		t.Errorf("Expected: %f, got: %f", 41.5599646, ra)
+	}
+	if !mathutils.AlmostEqual(dec, 49.3520685, 0.5*_ARCSEC) {
+		t.Errorf("Expected: %f, got: %f", 49.3520685, dec)
+	}
+}
@@ -0,0 +1,99 @@
+// Position of the Sun: geometric and apparent ecliptic longitude, right
+// ascension, declination and distance.
+//
+// The low accuracy mode is based on the Sun's mean elements and gives
+// about 0.01 arc-degree accuracy. The high accuracy mode uses truncated
+// VSOP87 series for the Earth from vsop87 package.
+//
+// All functions accept Julian Ephemeris Date (JDE). To obtain it, correct UT
+// Julian Date with deltat.DeltaT:
+//
+//	jde := jd + deltat.DeltaT(jd)/86400
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, chapter 25.
+package sun
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/nutequ"
+	"github.com/skrushinsky/scaliger/vsop87"
+)
+
+// Constant of aberration, arc-degrees
+const ABERRATION = 20.4898 / 3600
+
+// Accuracy of the calculations.
+type Accuracy int
+
+const (
+	// Sun's mean elements, accuracy about 0.01 arc-degree.
+	LowAccuracy Accuracy = iota
+	// Truncated VSOP87 series for the Earth, accuracy about 1 arcsecond.
+	HighAccuracy
+)
+
+// Ecliptic position of the Sun.
+type Position struct {
+	// ecliptic longitude, arc-degrees
+	Lon float64
+	// ecliptic latitude, arc-degrees
+	Lat float64
+	// distance, AU
+	R float64
+}
+
+func lowGeometric(t float64) Position {
+	l0 := mathutils.Polynome(t, 280.46646, 36000.76983, 0.0003032)                    // mean longitude
+	m := mathutils.Radians(mathutils.Polynome(t, 357.52911, 35999.05029, -0.0001537)) // mean anomaly
+	e := mathutils.Polynome(t, 0.016708634, -0.000042037, -0.0000001267)              // eccentricity of the Earth's orbit
+	// equation of the center
+	c := (1.914602-0.004817*t-0.000014*t*t)*math.Sin(m) +
+		(0.019993-0.000101*t)*math.Sin(2*m) +
+		0.000289*math.Sin(3*m)
+	v := m + mathutils.Radians(c) // true anomaly
+	r := 1.000001018 * (1 - e*e) / (1 + e*math.Cos(v))
+	return Position{Lon: mathutils.ReduceDeg(l0 + c), R: r}
+}
+
+func highGeometric(jde, t float64) Position {
+	l, b, r := vsop87.Earth.Heliocentric(jde)
+	lon := mathutils.ReduceDeg(l + 180)
+	lat := -b
+	// conversion to the FK5 system
+	l1 := mathutils.Radians(mathutils.Polynome(t, lon, -1.397, -0.00031))
+	lon += -0.09033 / 3600
+	lat += 0.03916 / 3600 * (math.Cos(l1) - math.Sin(l1))
+	return Position{Lon: mathutils.ReduceDeg(lon), Lat: lat, R: r}
+}
+
+// Given [jde], Julian Ephemeris Date, calculate geometric position of the
+// Sun, referred to the mean equinox of the date.
+func Geometric(jde float64, accuracy Accuracy) Position {
+	t := (jde - julian.J2000) / julian.DAYS_PER_CENT
+	if accuracy == HighAccuracy {
+		return highGeometric(jde, t)
+	}
+	return lowGeometric(t)
+}
+
+// Given [jde], Julian Ephemeris Date, calculate apparent position of the
+// Sun, corrected for nutation and aberration, referred to the true equinox
+// of the date.
+func Apparent(jde float64, accuracy Accuracy) Position {
+	pos := Geometric(jde, accuracy)
+	dpsi, _ := nutequ.Nutation(jde)
+	pos.Lon = mathutils.ReduceDeg(pos.Lon + dpsi - ABERRATION/pos.R)
+	return pos
+}
+
+// Given [jde], Julian Ephemeris Date, calculate apparent right ascension
+// and declination of the Sun in arc-degrees.
+func Equatorial(jde float64, accuracy Accuracy) (ra float64, dec float64) {
+	pos := Apparent(jde, accuracy)
+	_, deps := nutequ.Nutation(jde)
+	return coords.EclipticToEquatorial(pos.Lon, pos.Lat, nutequ.TrueObliquity(jde, deps))
+}
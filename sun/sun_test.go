@@ -0,0 +1,59 @@
+package sun
+
+import (
+	"testing"
+
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// 1992 Oct 13.0 TD, J.Meeus, "Astronomical Algorithms", examples 25.a and 25.b
+const _JDE = 2448908.5
+
+type _SunTestCase struct {
+	accuracy Accuracy
+	lon      float64
+	r        float64
+	ra       float64
+	dec      float64
+	delta    float64
+}
+
+var cases = [...]_SunTestCase{
+	{accuracy: LowAccuracy, lon: 199.90988, r: 0.99766, ra: 198.38083, dec: -7.78507, delta: 1e-3},
+	{accuracy: HighAccuracy, lon: 199.907347, r: 0.99760775, ra: 198.378121, dec: -7.783817, delta: 1e-4},
+}
+
+func TestGeometric(t *testing.T) {
+	for _, test := range cases {
+		pos := Geometric(_JDE, test.accuracy)
+		if !mathutils.AlmostEqual(pos.Lon, test.lon, 1e-5) {
+			t.Errorf("Expected: %f, got: %f", test.lon, pos.Lon)
+		}
+		if !mathutils.AlmostEqual(pos.R, test.r, 1e-5) {
+			t.Errorf("Expected: %f, got: %f", test.r, pos.R)
+		}
+	}
+}
+
+func TestApparentLongitude(t *testing.T) {
+	// Meeus gives nutation +15.908" and aberration -20.539" for this date
+	for _, test := range cases {
+		pos := Apparent(_JDE, test.accuracy)
+		exp := test.lon + (15.908-20.539)/3600
+		if !mathutils.AlmostEqual(pos.Lon, exp, 1e-4) {
+			t.Errorf("Expected: %f, got: %f", exp, pos.Lon)
+		}
+	}
+}
+
+func TestEquatorial(t *testing.T) {
+	for _, test := range cases {
+		ra, dec := Equatorial(_JDE, test.accuracy)
+		if !mathutils.AlmostEqual(ra, test.ra, test.delta) {
+			t.Errorf("Expected: %f, got: %f", test.ra, ra)
+		}
+		if !mathutils.AlmostEqual(dec, test.dec, test.delta) {
+			t.Errorf("Expected: %f, got: %f", test.dec, dec)
+		}
+	}
+}
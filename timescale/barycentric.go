@@ -0,0 +1,112 @@
+package timescale
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/coords"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/nutequ"
+	"github.com/skrushinsky/scaliger/observer"
+	"github.com/skrushinsky/scaliger/planets"
+	"github.com/skrushinsky/scaliger/sidereal"
+	"github.com/skrushinsky/scaliger/vsop87"
+)
+
+// 2GM/c³ for the Sun, seconds.
+const SHAPIRO = 9.8510e-6
+
+// Planets with Sun/planet mass ratios; the Earth includes the Moon.
+var _MASSES = []struct {
+	planet *vsop87.Planet
+	ratio  float64
+}{
+	{&vsop87.Mercury, 6023600},
+	{&vsop87.Venus, 408523.71},
+	{&vsop87.Earth, 328900.56},
+	{&vsop87.Mars, 3098708},
+	{&vsop87.Jupiter, 1047.3486},
+	{&vsop87.Saturn, 3497.898},
+	{&vsop87.Uranus, 22902.98},
+	{&vsop87.Neptune, 19412.24},
+}
+
+func heliocentric(p *vsop87.Planet, jde float64) coords.Cartesian {
+	l, b, r := p.Heliocentric(jde)
+	return coords.Spherical{Lon: l, Lat: b, R: r}.Cartesian()
+}
+
+// Rotation from the ecliptic of the date to the equator of J2000.
+func toJ2000(jde float64) coords.Matrix {
+	return coords.PrecessionMatrix(jde, julian.J2000).Mul(coords.EclipticToEquatorialMatrix(nutequ.MeanObliquity(jde)))
+}
+
+// Given [jde], calculate heliocentric position of the Earth and position of
+// the Sun relative to the Solar System barycenter, AU, equatorial J2000.
+func earthAndSun(jde float64) (earth coords.Cartesian, sun coords.Cartesian) {
+	total := 1.0
+	for _, m := range _MASSES {
+		p := heliocentric(m.planet, jde)
+		sun.X -= p.X / m.ratio
+		sun.Y -= p.Y / m.ratio
+		sun.Z -= p.Z / m.ratio
+		total += 1 / m.ratio
+	}
+	sun = coords.Cartesian{X: sun.X / total, Y: sun.Y / total, Z: sun.Z / total}
+	m := toJ2000(jde)
+	return m.Apply(heliocentric(&vsop87.Earth, jde)), m.Apply(sun)
+}
+
+func project(c coords.Cartesian, n [3]float64) float64 {
+	return c.X*n[0] + c.Y*n[1] + c.Z*n[2]
+}
+
+// Given [jd], UTC Julian Date of an observation and [ra], [dec], J2000
+// coordinates of the target, arc-degrees, calculate Heliocentric Julian
+// Date, UTC: the moment when the light would have reached the Sun.
+//
+// The correction reaches ±8.3 minutes. HJD is kept on the UTC scale by
+// convention, though it is ambiguous at the level of a minute; use BJD for
+// precise timings.
+func HJD(jd, ra, dec float64) float64 {
+	jde := UTCToTT(jd)
+	earth, _ := earthAndSun(jde)
+	return jd + project(earth, direction(ra, dec))*planets.LIGHT_TIME
+}
+
+func bjd(jd, ra, dec float64, topo func(jde float64) coords.Cartesian) float64 {
+	jde := UTCToTT(jd)
+	earth, sun := earthAndSun(jde)
+	n := direction(ra, dec)
+	pos := coords.Cartesian{X: earth.X + sun.X, Y: earth.Y + sun.Y, Z: earth.Z + sun.Z}
+	if topo != nil {
+		o := topo(jde)
+		pos = coords.Cartesian{X: pos.X + o.X, Y: pos.Y + o.Y, Z: pos.Z + o.Z}
+		earth = coords.Cartesian{X: earth.X + o.X, Y: earth.Y + o.Y, Z: earth.Z + o.Z}
+	}
+	romer := project(pos, n) * planets.LIGHT_TIME
+	r := math.Sqrt(earth.X*earth.X + earth.Y*earth.Y + earth.Z*earth.Z)
+	shapiro := SHAPIRO * math.Log(1+project(earth, n)/r)
+	return TTToTDB(jde) + romer + shapiro/julian.SEC_PER_DAY
+}
+
+// Given [jd], UTC Julian Date of an observation and [ra], [dec], J2000
+// coordinates of the target, arc-degrees, calculate Barycentric Julian
+// Date in TDB scale, including Rømer delay to the Solar System barycenter,
+// Einstein delay and Shapiro delay of the Sun.
+//
+// The observer is assumed to be at the center of the Earth, which causes
+// errors up to 21 ms; see BJDAt. Accuracy of the embedded planetary
+// theories limits the result to a few milliseconds.
+func BJD(jd, ra, dec float64) float64 {
+	return bjd(jd, ra, dec, nil)
+}
+
+// Same as BJD, for an observer on the Earth surface.
+func BJDAt(jd, ra, dec float64, obs observer.Observer) float64 {
+	return bjd(jd, ra, dec, func(jde float64) coords.Cartesian {
+		lst := sidereal.JulianToSidereal(jd, obs.SiderealOptions())
+		p := obs.Position(lst)
+		k := 1 / observer.AU
+		return coords.PrecessionMatrix(jde, julian.J2000).Apply(coords.Cartesian{X: p.X * k, Y: p.Y * k, Z: p.Z * k})
+	})
+}
@@ -0,0 +1,116 @@
+// Conversions between UTC, Terrestrial Time (TT) and Barycentric Dynamical
+// Time (TDB), and heliocentric and barycentric corrections of observation
+// times.
+//
+// Before 1972, when UTC was not tied to atomic time by whole leap seconds,
+// TT is obtained from UT with deltat.DeltaT.
+//
+// Sources:
+//   - "Explanatory Supplement to the Astronomical Almanac", 1992, section 2.222.
+//   - J.Eastman, R.Siverd, B.S.Gaudi, "Achieving Better Than 1 Minute Accuracy
+//     in the Heliocentric and Barycentric Julian Dates", PASP, 122:935, 2010.
+package timescale
+
+import (
+	"math"
+	"sort"
+
+	"github.com/skrushinsky/scaliger/deltat"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// TT − TAI, seconds.
+const TT_TAI = 32.184
+
+// Leap seconds: Julian Date of 0h UTC, when TAI − UTC took the given value.
+type _LeapSecond struct {
+	jd    float64
+	value float64
+}
+
+var _LEAP_SECONDS = []_LeapSecond{
+	{2441317.5, 10}, // 1972 Jan 1
+	{2441499.5, 11}, // 1972 Jul 1
+	{2441683.5, 12}, // 1973 Jan 1
+	{2442048.5, 13}, // 1974 Jan 1
+	{2442413.5, 14}, // 1975 Jan 1
+	{2442778.5, 15}, // 1976 Jan 1
+	{2443144.5, 16}, // 1977 Jan 1
+	{2443509.5, 17}, // 1978 Jan 1
+	{2443874.5, 18}, // 1979 Jan 1
+	{2444239.5, 19}, // 1980 Jan 1
+	{2444786.5, 20}, // 1981 Jul 1
+	{2445151.5, 21}, // 1982 Jul 1
+	{2445516.5, 22}, // 1983 Jul 1
+	{2446247.5, 23}, // 1985 Jul 1
+	{2447161.5, 24}, // 1988 Jan 1
+	{2447892.5, 25}, // 1990 Jan 1
+	{2448257.5, 26}, // 1991 Jan 1
+	{2448804.5, 27}, // 1992 Jul 1
+	{2449169.5, 28}, // 1993 Jul 1
+	{2449534.5, 29}, // 1994 Jul 1
+	{2450083.5, 30}, // 1996 Jan 1
+	{2450630.5, 31}, // 1997 Jul 1
+	{2451179.5, 32}, // 1999 Jan 1
+	{2453736.5, 33}, // 2006 Jan 1
+	{2454832.5, 34}, // 2009 Jan 1
+	{2456109.5, 35}, // 2012 Jul 1
+	{2457204.5, 36}, // 2015 Jul 1
+	{2457754.5, 37}, // 2017 Jan 1
+}
+
+// Given [jd], UTC Julian Date, return TAI − UTC, seconds. The second value
+// is false before 1972, when the leap seconds were not in use. After the
+// last tabulated leap second the last value is returned.
+func LeapSeconds(jd float64) (float64, bool) {
+	i := sort.Search(len(_LEAP_SECONDS), func(i int) bool {
+		return _LEAP_SECONDS[i].jd > jd
+	})
+	if i == 0 {
+		return 0, false
+	}
+	return _LEAP_SECONDS[i-1].value, true
+}
+
+// Given [jd], UTC Julian Date, calculate Terrestrial Time.
+func UTCToTT(jd float64) float64 {
+	if ls, ok := LeapSeconds(jd); ok {
+		return jd + (ls+TT_TAI)/julian.SEC_PER_DAY
+	}
+	return jd + deltat.DeltaT(jd)/julian.SEC_PER_DAY
+}
+
+// Periodic terms of TDB − TT: amplitude, seconds, frequency, radians per
+// century and phase, radians.
+var _TDB_TERMS = [...][3]float64{
+	{0.001657, 628.3076, 6.2401},
+	{0.000022, 575.3385, 4.2970},
+	{0.000014, 1256.6152, 6.1969},
+	{0.000005, 606.9777, 4.0212},
+	{0.000005, 52.9691, 0.4444},
+	{0.000002, 21.3299, 5.5431},
+}
+
+// Given [jd], TT Julian Date, calculate TDB − TT, seconds (Einstein delay).
+// The error is about 30 microseconds.
+func TDBMinusTT(jd float64) float64 {
+	t := (jd - julian.J2000) / julian.DAYS_PER_CENT
+	res := 0.000010 * t * math.Sin(628.3076*t+4.2490)
+	for _, k := range _TDB_TERMS {
+		res += k[0] * math.Sin(k[1]*t+k[2])
+	}
+	return res
+}
+
+// Given [jd], TT Julian Date, calculate TDB Julian Date.
+func TTToTDB(jd float64) float64 {
+	return jd + TDBMinusTT(jd)/julian.SEC_PER_DAY
+}
+
+// Unit vector towards [ra], [dec], arc-degrees.
+func direction(ra, dec float64) [3]float64 {
+	sa, ca := math.Sincos(mathutils.Radians(ra))
+	sd, cd := math.Sincos(mathutils.Radians(dec))
+	return [3]float64{cd * ca, cd * sa, sd}
+}
@@ -0,0 +1,94 @@
+package timescale
+
+import (
+	"math"
+	"testing"
+
+	"github.com/skrushinsky/scaliger/deltat"
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+	"github.com/skrushinsky/scaliger/observer"
+	"github.com/skrushinsky/scaliger/planets"
+)
+
+func TestLeapSeconds(t *testing.T) {
+	cases := []struct {
+		jd  float64
+		exp float64
+	}{
+		{jd: 2441317.5, exp: 10},  // 1972 Jan 1
+		{jd: 2453736.49, exp: 32}, // 2005 Dec 31, before the leap second
+		{jd: 2453736.5, exp: 33},
+		{jd: 2460000.5, exp: 37},
+	}
+	for _, test := range cases {
+		got, ok := LeapSeconds(test.jd)
+		if !ok || got != test.exp {
+			t.Errorf("Expected: %f, got: %f", test.exp, got)
+		}
+	}
+	if _, ok := LeapSeconds(2441317.4); ok {
+		t.Errorf("Leap seconds should be unknown before 1972")
+	}
+}
+
+func TestUTCToTT(t *testing.T) {
+	jd := 2460000.5
+	got := (UTCToTT(jd) - jd) * julian.SEC_PER_DAY
+	if !mathutils.AlmostEqual(got, 69.184, 1e-4) {
+		t.Errorf("Expected: %f, got: %f", 69.184, got)
+	}
+	// before 1972 Delta-T is used
+	jd = 2440000.5
+	got = (UTCToTT(jd) - jd) * julian.SEC_PER_DAY
+	if !mathutils.AlmostEqual(got, deltat.DeltaT(jd), 1e-4) {
+		t.Errorf("Expected: %f, got: %f", deltat.DeltaT(jd), got)
+	}
+}
+
+func TestTDBMinusTT(t *testing.T) {
+	max := 0.0
+	for jd := julian.J2000; jd < julian.J2000+366; jd++ {
+		max = math.Max(max, math.Abs(TDBMinusTT(jd)))
+	}
+	if !mathutils.AlmostEqual(max, 0.00166, 0.00003) {
+		t.Errorf("Expected: %f, got: %f", 0.00166, max)
+	}
+}
+
+func TestHJD(t *testing.T) {
+	jd := 2460000.5
+	earth, _ := earthAndSun(UTCToTT(jd))
+	s := earth.Spherical()
+	r := s.R * planets.LIGHT_TIME
+	// towards the Earth, the light comes to the Sun later
+	if got := HJD(jd, s.Lon, s.Lat) - jd; !mathutils.AlmostEqual(got, r, 1e-8) {
+		t.Errorf("Expected: %f, got: %f", r, got)
+	}
+	if got := HJD(jd, mathutils.ReduceDeg(s.Lon+180), -s.Lat) - jd; !mathutils.AlmostEqual(got, -r, 1e-8) {
+		t.Errorf("Expected: %f, got: %f", -r, got)
+	}
+	// north pole of the ecliptic, J2000
+	if got := (HJD(jd, 270, 66.560709) - jd) * julian.SEC_PER_DAY; math.Abs(got) > 0.1 {
+		t.Errorf("Expected: %f, got: %f", 0.0, got)
+	}
+}
+
+func TestBJD(t *testing.T) {
+	// IP Peg, 2010 Jan 1
+	ra := mathutils.AngleFromHMS(false, 23, 23, 8.55).Degrees()
+	dec := mathutils.AngleFromDMS(false, 18, 24, 59.3).Degrees()
+	jd := 2455197.5
+	tdb := TTToTDB(UTCToTT(jd))
+	bary := (BJD(jd, ra, dec) - tdb) * julian.SEC_PER_DAY
+	helio := (HJD(jd, ra, dec) - jd) * julian.SEC_PER_DAY
+	// the barycenter is within 0.01 AU from the Sun
+	if math.Abs(bary-helio) > 5 {
+		t.Errorf("Expected difference less than 5s, got: %f", bary-helio)
+	}
+	// the center of the Earth is at most 21 ms from the observer
+	obs := BJDAt(jd, ra, dec, observer.Observer{Lat: 51.4769, Height: 46})
+	if got := math.Abs(obs-BJD(jd, ra, dec)) * julian.SEC_PER_DAY; got > 0.0213 || got == 0 {
+		t.Errorf("Expected less than 21.3 ms, got: %f", got*1000)
+	}
+}
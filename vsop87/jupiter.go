@@ -0,0 +1,507 @@
+package vsop87
+
+// Jupiter, heliocentric ecliptic coordinates referred to the mean equinox
+// of the date, truncated according to J.Meeus, "Astronomical Algorithms",
+// Appendix III.
+var Jupiter = Planet{
+	L: Series{
+		{ // L0
+			{59954691e-8, 0, 0},
+			{9695899e-8, 5.0619179, 529.6909651},
+			{573610e-8, 1.444062, 7.113547},
+			{306389e-8, 5.417347, 1059.381930},
+			{97178e-8, 4.14265, 632.78374},
+			{72903e-8, 3.64043, 522.57742},
+			{64264e-8, 3.41145, 103.09277},
+			{39806e-8, 2.29377, 419.48464},
+			{38858e-8, 1.27232, 316.39187},
+			{27965e-8, 1.78455, 536.80451},
+			{13590e-8, 5.77481, 1589.07290},
+			{8769e-8, 3.6300, 949.1756},
+			{8246e-8, 3.5823, 206.1855},
+			{7368e-8, 5.0810, 735.8765},
+			{6263e-8, 0.0250, 213.2991},
+			{6114e-8, 4.5132, 1162.4747},
+			{5305e-8, 4.1863, 1052.2684},
+			{5305e-8, 1.3067, 14.2271},
+			{4905e-8, 1.3208, 110.2063},
+			{4647e-8, 4.6996, 3.9322},
+			{3045e-8, 4.3168, 426.5982},
+			{2610e-8, 1.5667, 846.0828},
+			{2028e-8, 1.0638, 3.1814},
+			{1921e-8, 0.9717, 639.8973},
+			{1765e-8, 2.1415, 1066.4955},
+			{1723e-8, 3.8804, 1265.5675},
+			{1633e-8, 3.5820, 515.4639},
+			{1432e-8, 4.2968, 625.6702},
+			{973e-8, 4.098, 95.979},
+			{884e-8, 2.437, 412.371},
+			{733e-8, 6.085, 838.969},
+			{731e-8, 3.806, 1581.959},
+			{709e-8, 1.293, 742.990},
+			{692e-8, 6.134, 2118.764},
+			{614e-8, 4.109, 1478.867},
+			{582e-8, 4.540, 309.278},
+			{495e-8, 3.756, 323.505},
+			{441e-8, 2.958, 454.909},
+			{417e-8, 1.036, 2.448},
+			{390e-8, 4.897, 1692.166},
+			{376e-8, 4.703, 1368.660},
+			{341e-8, 5.715, 533.623},
+			{330e-8, 4.740, 0.048},
+			{262e-8, 1.877, 0.963},
+			{261e-8, 0.820, 380.128},
+			{257e-8, 3.724, 199.072},
+			{244e-8, 5.220, 728.763},
+			{235e-8, 1.227, 909.819},
+			{220e-8, 1.651, 543.918},
+			{207e-8, 1.855, 525.759},
+			{202e-8, 1.807, 1375.774},
+			{197e-8, 5.293, 1155.361},
+			{175e-8, 3.730, 942.062},
+			{175e-8, 3.226, 1898.351},
+			{175e-8, 5.910, 956.289},
+			{158e-8, 4.365, 1795.258},
+			{151e-8, 3.906, 74.782},
+			{149e-8, 4.377, 1685.052},
+			{141e-8, 3.136, 491.558},
+			{138e-8, 1.318, 1169.588},
+			{131e-8, 4.169, 1045.155},
+			{117e-8, 2.500, 1596.186},
+			{117e-8, 3.389, 0.521},
+			{106e-8, 4.554, 526.510},
+		},
+		{ // L1
+			{52993480757e-8, 0, 0},
+			{489741e-8, 4.220667, 529.690965},
+			{228919e-8, 6.026475, 7.113547},
+			{27655e-8, 4.57266, 1059.38193},
+			{20721e-8, 5.45939, 522.57742},
+			{12106e-8, 0.16986, 536.80451},
+			{6068e-8, 4.4242, 103.0928},
+			{5434e-8, 3.9848, 419.4846},
+			{4238e-8, 5.8901, 14.2271},
+			{2212e-8, 5.2677, 206.1855},
+			{1746e-8, 4.9267, 1589.0729},
+			{1296e-8, 5.5513, 3.1814},
+			{1173e-8, 5.8565, 1052.2684},
+			{1163e-8, 0.5145, 3.9322},
+			{1099e-8, 5.3070, 515.4639},
+			{1007e-8, 0.4648, 735.8765},
+			{1004e-8, 3.1504, 426.5982},
+			{848e-8, 5.758, 110.206},
+			{827e-8, 4.803, 213.299},
+			{816e-8, 0.586, 1066.495},
+			{725e-8, 5.518, 639.897},
+			{568e-8, 5.989, 625.670},
+			{474e-8, 4.132, 412.371},
+			{413e-8, 5.737, 95.979},
+			{345e-8, 4.242, 632.784},
+			{336e-8, 3.732, 1162.475},
+			{234e-8, 4.035, 949.176},
+			{234e-8, 6.243, 309.278},
+			{199e-8, 1.505, 838.969},
+			{195e-8, 2.219, 323.505},
+			{187e-8, 6.086, 742.990},
+			{184e-8, 6.280, 543.918},
+			{171e-8, 5.417, 199.072},
+			{131e-8, 0.626, 728.763},
+			{115e-8, 0.680, 846.083},
+			{115e-8, 5.286, 2118.764},
+			{108e-8, 4.493, 956.289},
+			{80e-8, 5.82, 1045.15},
+			{72e-8, 5.34, 942.06},
+			{70e-8, 5.97, 532.87},
+			{67e-8, 5.73, 21.34},
+			{66e-8, 0.13, 526.51},
+			{65e-8, 6.09, 1581.96},
+			{59e-8, 0.59, 1155.36},
+			{58e-8, 0.99, 1596.19},
+			{57e-8, 5.97, 1169.59},
+			{57e-8, 1.41, 533.62},
+			{55e-8, 5.43, 10.29},
+			{52e-8, 5.73, 117.32},
+			{52e-8, 0.23, 1368.66},
+			{50e-8, 6.08, 525.76},
+			{47e-8, 3.63, 1478.87},
+			{47e-8, 0.51, 1265.57},
+			{40e-8, 4.16, 1692.17},
+			{34e-8, 0.10, 302.16},
+			{33e-8, 5.04, 220.41},
+			{32e-8, 5.37, 508.35},
+			{29e-8, 5.42, 1272.68},
+			{29e-8, 3.36, 4.67},
+			{29e-8, 0.76, 88.87},
+			{25e-8, 1.61, 831.86},
+		},
+		{ // L2
+			{47234e-8, 4.32148, 7.11355},
+			{38966e-8, 0, 0},
+			{30629e-8, 2.93021, 529.69097},
+			{3189e-8, 1.0550, 522.5774},
+			{2729e-8, 4.8455, 536.8045},
+			{2723e-8, 3.4141, 1059.3819},
+			{1721e-8, 4.1873, 14.2271},
+			{383e-8, 5.768, 419.485},
+			{378e-8, 0.760, 515.464},
+			{367e-8, 6.055, 103.093},
+			{337e-8, 3.786, 3.181},
+			{308e-8, 0.694, 206.186},
+			{218e-8, 3.814, 1589.073},
+			{199e-8, 5.340, 1066.495},
+			{197e-8, 2.484, 3.932},
+			{156e-8, 1.406, 1052.268},
+			{146e-8, 3.814, 639.897},
+			{142e-8, 1.634, 426.598},
+			{130e-8, 5.837, 412.371},
+			{117e-8, 1.414, 625.670},
+			{97e-8, 4.03, 110.21},
+			{91e-8, 1.11, 95.98},
+			{87e-8, 2.52, 632.78},
+			{79e-8, 4.64, 543.92},
+			{72e-8, 2.22, 735.88},
+			{58e-8, 0.83, 199.07},
+			{57e-8, 3.12, 213.30},
+			{49e-8, 1.67, 309.28},
+			{40e-8, 4.02, 21.34},
+			{40e-8, 0.62, 323.51},
+			{36e-8, 2.33, 728.76},
+			{29e-8, 3.61, 10.29},
+			{28e-8, 3.24, 838.97},
+			{26e-8, 4.50, 742.99},
+			{26e-8, 2.51, 1162.47},
+			{25e-8, 1.22, 1045.15},
+			{24e-8, 3.01, 956.29},
+			{19e-8, 4.29, 532.87},
+			{18e-8, 0.81, 508.35},
+			{17e-8, 4.20, 2118.76},
+			{17e-8, 1.83, 526.51},
+			{15e-8, 5.81, 1155.36},
+		},
+		{ // L3
+			{6502e-8, 2.5986, 7.1135},
+			{1357e-8, 1.3464, 529.6910},
+			{471e-8, 2.475, 14.227},
+			{417e-8, 3.245, 536.805},
+			{353e-8, 2.974, 522.577},
+			{155e-8, 2.076, 1059.382},
+			{87e-8, 2.51, 515.46},
+			{44e-8, 0, 0},
+			{34e-8, 3.83, 1066.50},
+			{28e-8, 2.45, 206.19},
+			{24e-8, 1.28, 412.37},
+			{23e-8, 2.98, 543.92},
+			{20e-8, 2.10, 639.90},
+			{20e-8, 1.40, 419.48},
+			{19e-8, 1.59, 103.09},
+			{17e-8, 2.30, 21.34},
+			{17e-8, 2.60, 1589.07},
+			{16e-8, 3.15, 625.67},
+			{16e-8, 3.36, 1052.27},
+			{13e-8, 2.76, 95.98},
+			{13e-8, 2.54, 199.07},
+			{13e-8, 6.27, 426.60},
+			{9e-8, 1.76, 10.29},
+			{9e-8, 2.27, 110.21},
+			{7e-8, 3.43, 309.28},
+			{7e-8, 4.04, 728.76},
+			{6e-8, 2.52, 508.35},
+			{5e-8, 2.91, 1045.15},
+		},
+		{ // L4
+			{669e-8, 0.853, 7.114},
+			{114e-8, 3.142, 0},
+			{100e-8, 0.743, 14.227},
+			{50e-8, 1.65, 536.80},
+			{44e-8, 5.82, 529.69},
+			{32e-8, 4.86, 522.58},
+			{15e-8, 4.29, 515.46},
+			{9e-8, 0.71, 1059.38},
+			{5e-8, 1.30, 543.92},
+			{4e-8, 2.32, 1066.50},
+			{4e-8, 0.48, 21.34},
+			{3e-8, 3.00, 412.37},
+			{2e-8, 0.40, 639.90},
+			{2e-8, 4.26, 199.07},
+			{2e-8, 4.91, 625.67},
+			{2e-8, 4.26, 206.19},
+		},
+		{ // L5
+			{50e-8, 5.26, 7.11},
+			{16e-8, 5.25, 14.23},
+			{4e-8, 0.01, 536.80},
+			{2e-8, 1.10, 522.58},
+			{1e-8, 3.14, 0},
+		},
+	},
+	B: Series{
+		{ // B0
+			{2268616e-8, 3.5585261, 529.6909651},
+			{110090e-8, 0, 0},
+			{109972e-8, 3.908093, 1059.381930},
+			{8101e-8, 3.6051, 522.5774},
+			{6438e-8, 0.3063, 536.8045},
+			{6044e-8, 4.2588, 1589.0729},
+			{1107e-8, 2.9853, 1162.4747},
+			{944e-8, 1.675, 426.598},
+			{942e-8, 2.936, 1052.268},
+			{894e-8, 1.754, 7.114},
+			{836e-8, 5.179, 103.093},
+			{767e-8, 2.155, 632.784},
+			{684e-8, 3.678, 213.299},
+			{629e-8, 0.643, 1066.495},
+			{559e-8, 0.014, 846.083},
+			{532e-8, 2.703, 110.206},
+			{464e-8, 1.173, 949.176},
+			{431e-8, 2.608, 419.485},
+			{351e-8, 4.611, 2118.764},
+			{132e-8, 4.778, 742.990},
+			{123e-8, 3.350, 1692.166},
+			{116e-8, 1.387, 323.505},
+			{115e-8, 5.049, 316.392},
+			{104e-8, 3.701, 515.464},
+			{103e-8, 2.319, 1478.867},
+			{102e-8, 3.153, 1581.959},
+		},
+		{ // B1
+			{177352e-8, 5.701665, 529.690965},
+			{3230e-8, 5.7794, 1059.3819},
+			{3081e-8, 5.4746, 522.5774},
+			{2212e-8, 4.7348, 536.8045},
+			{1694e-8, 3.1416, 0},
+			{346e-8, 4.746, 1052.268},
+			{234e-8, 5.189, 1066.495},
+			{196e-8, 6.186, 7.114},
+			{150e-8, 3.927, 1589.073},
+			{114e-8, 3.439, 632.784},
+			{97e-8, 2.91, 949.18},
+			{82e-8, 5.08, 1162.47},
+			{77e-8, 2.51, 103.09},
+			{77e-8, 0.61, 419.48},
+			{74e-8, 5.50, 515.46},
+			{61e-8, 5.45, 213.30},
+			{50e-8, 3.95, 735.88},
+			{46e-8, 0.54, 110.21},
+			{45e-8, 1.90, 846.08},
+			{37e-8, 4.70, 543.92},
+			{36e-8, 6.11, 316.39},
+			{32e-8, 4.92, 1581.96},
+		},
+		{ // B2
+			{8094e-8, 1.4632, 529.6910},
+			{813e-8, 3.1416, 0},
+			{742e-8, 0.957, 522.577},
+			{399e-8, 2.899, 536.805},
+			{342e-8, 1.447, 1059.382},
+			{74e-8, 0.41, 1052.27},
+			{46e-8, 3.48, 1066.50},
+			{30e-8, 1.93, 1589.07},
+			{29e-8, 0.99, 515.46},
+			{23e-8, 4.27, 7.11},
+			{14e-8, 2.92, 543.92},
+			{12e-8, 5.22, 632.78},
+			{11e-8, 4.88, 949.18},
+			{6e-8, 6.21, 1045.15},
+		},
+		{ // B3
+			{252e-8, 3.381, 529.691},
+			{122e-8, 2.733, 522.577},
+			{49e-8, 1.04, 536.80},
+			{11e-8, 2.31, 1059.38},
+			{8e-8, 2.77, 515.46},
+			{7e-8, 4.25, 1052.27},
+			{6e-8, 1.78, 1066.50},
+			{4e-8, 1.13, 543.92},
+			{3e-8, 3.14, 0},
+		},
+		{ // B4
+			{15e-8, 4.53, 522.58},
+			{5e-8, 4.47, 529.69},
+			{4e-8, 5.44, 536.80},
+			{3e-8, 0, 0},
+			{2e-8, 4.52, 515.46},
+			{1e-8, 4.20, 1052.27},
+		},
+		{ // B5
+			{1e-8, 0.09, 522.58},
+		},
+	},
+	R: Series{
+		{ // R0
+			{520887429e-8, 0, 0},
+			{25209327e-8, 3.49108640, 529.69096509},
+			{610600e-8, 3.841154, 1059.381930},
+			{282029e-8, 2.574199, 632.783739},
+			{187647e-8, 2.075904, 522.577418},
+			{86793e-8, 0.71001, 419.48464},
+			{72063e-8, 0.21466, 536.80451},
+			{65517e-8, 5.97996, 316.39187},
+			{30135e-8, 2.16132, 949.17561},
+			{29135e-8, 1.67759, 103.09277},
+			{23947e-8, 0.27458, 7.11355},
+			{23453e-8, 3.54023, 735.87651},
+			{22284e-8, 4.19363, 1589.07290},
+			{13033e-8, 2.96043, 1162.47470},
+			{12749e-8, 2.71550, 1052.26838},
+			{9703e-8, 1.9067, 206.1855},
+			{9161e-8, 4.4135, 213.2991},
+			{7895e-8, 2.4791, 426.5982},
+			{7058e-8, 2.1818, 1265.5675},
+			{6138e-8, 6.2642, 846.0828},
+			{5477e-8, 5.6573, 639.8973},
+			{4170e-8, 2.0161, 515.4639},
+			{4137e-8, 2.7222, 625.6702},
+			{3503e-8, 0.5653, 1066.4955},
+			{2617e-8, 2.0099, 1581.9593},
+			{2500e-8, 4.5518, 838.9693},
+			{2128e-8, 6.1275, 742.9901},
+			{1912e-8, 0.8562, 412.3711},
+			{1611e-8, 3.0887, 1368.6603},
+			{1479e-8, 2.6803, 1478.8666},
+			{1231e-8, 1.8904, 323.5054},
+			{1217e-8, 1.8017, 110.2063},
+			{1015e-8, 1.3867, 454.9094},
+			{999e-8, 2.872, 309.278},
+			{961e-8, 4.549, 2118.764},
+			{886e-8, 4.148, 533.623},
+			{821e-8, 1.593, 1898.351},
+			{812e-8, 5.941, 909.819},
+			{777e-8, 3.677, 728.763},
+			{727e-8, 3.988, 1155.361},
+			{655e-8, 2.791, 1685.052},
+			{654e-8, 3.382, 1692.166},
+			{621e-8, 4.823, 956.289},
+			{615e-8, 2.276, 942.062},
+			{562e-8, 0.081, 543.918},
+			{542e-8, 0.284, 525.759},
+		},
+		{ // R1
+			{1271802e-8, 2.6493751, 529.6909651},
+			{61662e-8, 3.00076, 1059.38193},
+			{53444e-8, 3.89718, 522.57742},
+			{41390e-8, 0, 0},
+			{31185e-8, 4.88277, 536.80451},
+			{11847e-8, 2.4133, 419.4846},
+			{9166e-8, 4.7598, 7.1135},
+			{3404e-8, 3.3469, 1589.0729},
+			{3203e-8, 5.2108, 735.8765},
+			{3176e-8, 2.7930, 103.0928},
+			{2806e-8, 3.7422, 515.4639},
+			{2677e-8, 4.3305, 1052.2684},
+			{2600e-8, 3.6344, 206.1855},
+			{2412e-8, 1.4695, 426.5982},
+			{2101e-8, 3.9276, 639.8973},
+			{1646e-8, 5.3095, 1066.4955},
+			{1641e-8, 4.4163, 625.6702},
+			{1050e-8, 3.1611, 213.2991},
+			{1025e-8, 2.5543, 412.3711},
+			{806e-8, 2.678, 632.784},
+			{741e-8, 2.171, 1162.475},
+			{677e-8, 6.250, 838.969},
+			{567e-8, 4.577, 742.990},
+			{485e-8, 2.469, 949.176},
+			{469e-8, 4.710, 543.918},
+			{445e-8, 0.403, 323.505},
+			{416e-8, 5.368, 728.763},
+			{402e-8, 4.605, 309.278},
+			{347e-8, 4.681, 14.227},
+			{338e-8, 3.168, 956.289},
+			{261e-8, 5.343, 846.083},
+			{247e-8, 3.923, 942.062},
+			{220e-8, 4.842, 1368.660},
+			{203e-8, 5.600, 1155.361},
+			{200e-8, 4.439, 1045.155},
+			{197e-8, 3.706, 2118.764},
+			{196e-8, 3.759, 199.072},
+			{184e-8, 4.265, 95.979},
+			{180e-8, 4.402, 532.872},
+			{170e-8, 4.846, 526.510},
+			{146e-8, 6.130, 533.623},
+			{133e-8, 1.322, 110.206},
+			{132e-8, 4.512, 525.759},
+		},
+		{ // R2
+			{79645e-8, 1.35866, 529.69097},
+			{8252e-8, 5.7777, 522.5774},
+			{7030e-8, 3.2748, 536.8045},
+			{5314e-8, 1.8384, 1059.3819},
+			{1861e-8, 2.9768, 7.1135},
+			{964e-8, 5.480, 515.464},
+			{836e-8, 4.199, 419.485},
+			{498e-8, 3.142, 0},
+			{427e-8, 2.228, 639.897},
+			{406e-8, 3.783, 1066.495},
+			{377e-8, 2.242, 1589.073},
+			{363e-8, 5.368, 206.186},
+			{342e-8, 6.099, 1052.268},
+			{339e-8, 6.127, 625.670},
+			{333e-8, 0.003, 426.598},
+			{280e-8, 4.262, 412.371},
+			{257e-8, 0.963, 632.784},
+			{230e-8, 0.705, 735.877},
+			{201e-8, 3.069, 543.918},
+			{200e-8, 4.429, 103.093},
+			{139e-8, 2.932, 14.227},
+			{114e-8, 0.787, 728.763},
+			{95e-8, 1.70, 838.97},
+			{86e-8, 5.14, 323.51},
+			{83e-8, 0.06, 309.28},
+			{80e-8, 2.98, 742.99},
+			{75e-8, 1.60, 956.29},
+			{70e-8, 1.51, 213.30},
+			{67e-8, 5.47, 199.07},
+			{62e-8, 6.10, 1045.15},
+			{56e-8, 0.96, 1162.47},
+			{52e-8, 5.58, 942.06},
+			{50e-8, 2.72, 532.87},
+			{45e-8, 5.52, 508.36},
+			{44e-8, 0.27, 526.51},
+			{40e-8, 5.95, 95.98},
+		},
+		{ // R3
+			{3519e-8, 6.0580, 529.6910},
+			{1073e-8, 1.6732, 536.8045},
+			{916e-8, 1.413, 522.577},
+			{342e-8, 0.523, 1059.382},
+			{255e-8, 1.196, 7.114},
+			{222e-8, 0.952, 515.464},
+			{90e-8, 3.14, 0},
+			{69e-8, 2.27, 1066.50},
+			{58e-8, 1.41, 543.92},
+			{57e-8, 0.53, 639.90},
+			{51e-8, 5.98, 412.37},
+			{47e-8, 1.58, 625.67},
+			{43e-8, 6.12, 419.48},
+			{37e-8, 1.18, 14.23},
+			{34e-8, 1.67, 1052.27},
+			{34e-8, 0.85, 206.19},
+			{31e-8, 1.04, 1589.07},
+			{30e-8, 4.63, 426.60},
+			{21e-8, 2.50, 728.76},
+			{15e-8, 0.89, 199.07},
+			{14e-8, 0.96, 508.36},
+			{13e-8, 1.50, 1045.15},
+			{12e-8, 2.61, 735.88},
+			{12e-8, 3.56, 323.51},
+			{11e-8, 1.79, 309.28},
+			{11e-8, 6.28, 956.29},
+			{10e-8, 6.26, 103.09},
+			{9e-8, 3.45, 838.97},
+		},
+		{ // R4
+			{129e-8, 0.084, 536.805},
+			{113e-8, 4.249, 529.691},
+			{83e-8, 3.30, 522.58},
+			{38e-8, 2.73, 515.46},
+			{27e-8, 5.69, 7.11},
+			{11e-8, 2.94, 0},
+		},
+		{ // R5
+			{11e-8, 4.75, 536.80},
+			{4e-8, 5.92, 522.58},
+			{2e-8, 5.57, 515.46},
+			{2e-8, 4.30, 543.92},
+			{2e-8, 3.69, 7.11},
+		},
+	},
+}
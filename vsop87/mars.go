@@ -0,0 +1,347 @@
+package vsop87
+
+// Mars, heliocentric ecliptic coordinates referred to the mean equinox
+// of the date, truncated according to J.Meeus, "Astronomical Algorithms",
+// Appendix III.
+var Mars = Planet{
+	L: Series{
+		{ // L0
+			{620347712e-8, 0, 0},
+			{18656368e-8, 5.05037100, 3340.61242670},
+			{1108217e-8, 5.4009984, 6681.2248534},
+			{91798e-8, 5.75479, 10021.83728},
+			{27745e-8, 5.97050, 3.52312},
+			{12316e-8, 0.84956, 2810.92146},
+			{10610e-8, 2.93959, 2281.23050},
+			{8927e-8, 4.1570, 0.0173},
+			{8716e-8, 6.1101, 13362.4497},
+			{7775e-8, 3.3397, 5621.8429},
+			{6798e-8, 0.3646, 398.1490},
+			{4161e-8, 0.2281, 2942.4634},
+			{3575e-8, 1.6619, 2544.3144},
+			{3075e-8, 0.8570, 191.4483},
+			{2938e-8, 6.0789, 0.0673},
+			{2628e-8, 0.6481, 3337.0893},
+			{2580e-8, 0.0300, 3344.1355},
+			{2389e-8, 5.0390, 796.2980},
+			{1799e-8, 0.6563, 529.6910},
+			{1546e-8, 2.9158, 1751.5395},
+			{1528e-8, 1.1498, 6151.5339},
+			{1286e-8, 3.0680, 2146.1654},
+			{1264e-8, 3.6228, 5092.1520},
+			{1025e-8, 3.6933, 8962.4553},
+			{892e-8, 0.183, 16703.062},
+			{859e-8, 2.401, 2914.014},
+			{833e-8, 4.495, 3340.630},
+			{833e-8, 2.464, 3340.595},
+			{749e-8, 3.822, 155.420},
+			{724e-8, 0.675, 3738.761},
+			{713e-8, 3.663, 1059.382},
+			{655e-8, 0.489, 3127.313},
+			{636e-8, 2.922, 8432.764},
+			{553e-8, 4.475, 1748.016},
+			{550e-8, 3.810, 0.980},
+			{472e-8, 3.625, 1194.447},
+			{426e-8, 0.554, 6283.076},
+			{415e-8, 0.497, 213.299},
+			{312e-8, 0.999, 6677.702},
+			{307e-8, 0.381, 6684.748},
+			{302e-8, 4.486, 3532.061},
+			{299e-8, 2.783, 6254.627},
+			{293e-8, 4.221, 20.775},
+			{284e-8, 5.769, 3149.164},
+			{281e-8, 5.882, 1349.867},
+			{274e-8, 0.542, 3340.545},
+			{274e-8, 0.134, 3340.680},
+			{239e-8, 5.372, 4136.910},
+			{236e-8, 5.755, 3333.499},
+			{231e-8, 1.282, 3870.303},
+			{221e-8, 3.505, 382.897},
+			{204e-8, 2.821, 1221.849},
+			{193e-8, 3.357, 3.590},
+			{189e-8, 1.491, 9492.146},
+			{179e-8, 1.006, 951.718},
+			{174e-8, 2.414, 553.569},
+			{172e-8, 0.439, 5486.778},
+			{160e-8, 3.949, 4562.461},
+			{144e-8, 1.419, 135.065},
+			{140e-8, 3.326, 2700.715},
+			{138e-8, 4.301, 7.114},
+			{131e-8, 4.045, 12303.068},
+			{128e-8, 2.208, 1592.596},
+			{128e-8, 1.807, 5088.629},
+			{117e-8, 3.128, 7903.073},
+			{113e-8, 3.701, 1589.073},
+			{110e-8, 1.052, 242.729},
+			{105e-8, 0.785, 8827.390},
+			{100e-8, 3.243, 11773.377},
+		},
+		{ // L1
+			{334085627474e-8, 0, 0},
+			{1458227e-8, 3.6042605, 3340.6124267},
+			{164901e-8, 3.926313, 6681.224853},
+			{19963e-8, 4.26594, 10021.83728},
+			{3452e-8, 4.7321, 3.5231},
+			{2485e-8, 4.6128, 13362.4497},
+			{842e-8, 4.459, 2281.230},
+			{538e-8, 5.016, 398.149},
+			{521e-8, 4.994, 3344.136},
+			{433e-8, 2.561, 191.448},
+			{430e-8, 5.316, 155.420},
+			{382e-8, 3.539, 796.298},
+			{314e-8, 4.963, 16703.062},
+			{283e-8, 3.160, 2544.314},
+			{206e-8, 4.569, 2146.165},
+			{169e-8, 1.329, 3337.089},
+			{158e-8, 4.185, 1751.540},
+			{134e-8, 2.233, 0.980},
+			{134e-8, 5.974, 1748.016},
+			{118e-8, 6.024, 6151.534},
+			{117e-8, 2.213, 1059.382},
+			{114e-8, 2.129, 1194.447},
+			{114e-8, 5.428, 3738.761},
+			{91e-8, 1.10, 1349.87},
+			{85e-8, 3.91, 553.57},
+			{83e-8, 5.30, 6684.75},
+			{81e-8, 4.43, 529.69},
+			{80e-8, 2.25, 8962.46},
+			{73e-8, 2.50, 951.72},
+			{73e-8, 5.84, 242.73},
+			{71e-8, 3.86, 2914.01},
+			{68e-8, 5.02, 382.90},
+			{65e-8, 1.02, 3340.60},
+			{65e-8, 3.05, 3340.63},
+			{62e-8, 4.15, 3149.16},
+			{57e-8, 3.89, 4136.91},
+			{48e-8, 4.87, 213.30},
+			{48e-8, 1.18, 3333.50},
+			{47e-8, 1.31, 3185.19},
+			{41e-8, 0.71, 1592.60},
+			{40e-8, 2.73, 7.11},
+			{40e-8, 5.32, 20043.67},
+			{33e-8, 5.41, 6283.08},
+			{28e-8, 0.05, 9492.15},
+			{27e-8, 3.89, 1221.85},
+			{27e-8, 5.11, 2700.72},
+		},
+		{ // L2
+			{58016e-8, 2.04979, 3340.61243},
+			{54188e-8, 0, 0},
+			{13908e-8, 2.45742, 6681.22485},
+			{2465e-8, 2.8000, 10021.8373},
+			{398e-8, 3.141, 13362.450},
+			{222e-8, 3.194, 3.523},
+			{121e-8, 0.543, 155.420},
+			{62e-8, 3.49, 16703.06},
+			{54e-8, 3.54, 3344.14},
+			{34e-8, 6.00, 2281.23},
+			{32e-8, 4.14, 191.45},
+			{30e-8, 2.00, 796.30},
+			{23e-8, 4.33, 242.73},
+			{22e-8, 3.45, 398.15},
+			{20e-8, 5.42, 553.57},
+			{16e-8, 0.66, 0.98},
+			{16e-8, 6.11, 2146.17},
+			{16e-8, 1.22, 1748.02},
+			{15e-8, 6.10, 3185.19},
+			{14e-8, 4.02, 951.72},
+			{14e-8, 2.62, 1349.87},
+			{13e-8, 0.60, 1194.45},
+			{12e-8, 3.86, 6684.75},
+			{11e-8, 4.72, 2544.31},
+			{10e-8, 0.25, 382.90},
+			{9e-8, 0.68, 1059.38},
+			{9e-8, 3.83, 20043.67},
+			{9e-8, 3.88, 3738.76},
+			{8e-8, 5.46, 1751.54},
+			{7e-8, 2.58, 3149.16},
+			{7e-8, 2.38, 4136.91},
+			{6e-8, 5.48, 1592.60},
+			{6e-8, 2.34, 3097.88},
+		},
+		{ // L3
+			{1482e-8, 0.4443, 3340.6124},
+			{662e-8, 0.885, 6681.225},
+			{188e-8, 1.288, 10021.837},
+			{41e-8, 1.65, 13362.45},
+			{26e-8, 0, 0},
+			{23e-8, 2.05, 155.42},
+			{10e-8, 1.58, 3.52},
+			{8e-8, 2.00, 16703.06},
+			{5e-8, 2.82, 242.73},
+			{4e-8, 2.02, 3344.14},
+			{3e-8, 4.59, 3185.19},
+			{3e-8, 0.65, 553.57},
+		},
+		{ // L4
+			{114e-8, 3.1416, 0},
+			{29e-8, 5.64, 6681.22},
+			{24e-8, 5.14, 3340.61},
+			{11e-8, 6.03, 10021.84},
+			{3e-8, 0.13, 13362.45},
+			{3e-8, 3.56, 155.42},
+			{1e-8, 0.49, 16703.06},
+			{1e-8, 1.32, 242.73},
+		},
+		{ // L5
+			{1e-8, 3.14, 0},
+			{1e-8, 4.04, 6681.22},
+		},
+	},
+	B: Series{
+		{ // B0
+			{3197135e-8, 3.7683204, 3340.6124267},
+			{298033e-8, 4.106170, 6681.224853},
+			{289105e-8, 0, 0},
+			{31366e-8, 4.44651, 10021.83728},
+			{3484e-8, 4.7881, 13362.4497},
+			{443e-8, 5.026, 3344.136},
+			{443e-8, 5.652, 3337.089},
+			{399e-8, 5.131, 16703.062},
+			{293e-8, 3.793, 2281.230},
+			{182e-8, 6.136, 6151.534},
+			{163e-8, 4.264, 529.691},
+			{160e-8, 2.232, 1059.382},
+			{149e-8, 2.165, 5621.843},
+			{143e-8, 1.182, 3340.595},
+			{143e-8, 3.213, 3340.630},
+			{139e-8, 2.418, 8962.455},
+		},
+		{ // B1
+			{350069e-8, 5.368478, 3340.612427},
+			{14116e-8, 3.14159, 0},
+			{9671e-8, 5.4788, 6681.2249},
+			{1472e-8, 3.2021, 10021.8373},
+			{426e-8, 3.408, 13362.450},
+			{102e-8, 0.776, 3337.089},
+			{79e-8, 3.72, 16703.06},
+			{33e-8, 3.46, 5621.84},
+			{26e-8, 2.48, 2281.23},
+		},
+		{ // B2
+			{16727e-8, 0.60221, 3340.61243},
+			{4987e-8, 3.1416, 0},
+			{302e-8, 5.559, 6681.225},
+			{26e-8, 1.90, 13362.45},
+			{21e-8, 0.92, 10021.84},
+			{12e-8, 2.24, 3337.09},
+			{8e-8, 2.25, 16703.06},
+		},
+		{ // B3
+			{607e-8, 1.981, 3340.612},
+			{43e-8, 0, 0},
+			{14e-8, 1.80, 6681.22},
+			{3e-8, 3.45, 10021.84},
+		},
+		{ // B4
+			{13e-8, 0, 0},
+			{11e-8, 3.46, 3340.61},
+			{1e-8, 0.50, 6681.22},
+		},
+	},
+	R: Series{
+		{ // R0
+			{153033488e-8, 0, 0},
+			{14184953e-8, 3.47971284, 3340.61242670},
+			{660776e-8, 3.817834, 6681.224853},
+			{46179e-8, 4.15595, 10021.83728},
+			{8110e-8, 5.5596, 2810.9215},
+			{7485e-8, 1.7724, 5621.8429},
+			{5523e-8, 1.3644, 2281.2305},
+			{3825e-8, 4.4941, 13362.4497},
+			{2484e-8, 4.9255, 2942.4634},
+			{2307e-8, 0.0908, 2544.3144},
+			{1999e-8, 5.3606, 3337.0893},
+			{1960e-8, 4.7425, 3344.1355},
+			{1167e-8, 2.1126, 5092.1520},
+			{1103e-8, 5.0091, 398.1490},
+			{992e-8, 5.839, 6151.534},
+			{899e-8, 4.408, 529.691},
+			{807e-8, 2.102, 1059.382},
+			{798e-8, 3.448, 796.298},
+			{741e-8, 1.499, 2146.165},
+			{726e-8, 1.245, 8432.764},
+			{692e-8, 2.134, 8962.455},
+			{633e-8, 0.894, 3340.595},
+			{633e-8, 2.924, 3340.630},
+			{630e-8, 1.287, 1751.540},
+			{574e-8, 0.829, 2914.014},
+			{526e-8, 5.383, 3738.761},
+			{473e-8, 5.199, 3127.313},
+			{348e-8, 4.832, 16703.062},
+			{284e-8, 2.907, 3532.061},
+			{280e-8, 5.257, 6283.076},
+			{276e-8, 1.218, 6254.627},
+			{275e-8, 2.908, 1748.016},
+			{270e-8, 3.764, 5884.927},
+			{239e-8, 2.037, 1194.447},
+			{234e-8, 5.105, 5486.778},
+			{228e-8, 3.255, 6872.673},
+			{223e-8, 4.199, 3149.164},
+			{219e-8, 5.583, 191.448},
+			{208e-8, 5.255, 3340.545},
+			{208e-8, 4.846, 3340.680},
+			{186e-8, 5.699, 6677.702},
+			{183e-8, 5.081, 6684.748},
+			{179e-8, 4.184, 3333.499},
+			{176e-8, 5.953, 3870.303},
+			{164e-8, 3.799, 4136.910},
+		},
+		{ // R1
+			{1107433e-8, 2.0325052, 3340.6124267},
+			{103176e-8, 2.370718, 6681.224853},
+			{12877e-8, 0, 0},
+			{10816e-8, 2.70888, 10021.83728},
+			{1195e-8, 3.0470, 13362.4497},
+			{439e-8, 2.888, 2281.230},
+			{396e-8, 3.423, 3344.136},
+			{183e-8, 1.584, 2544.314},
+			{136e-8, 3.385, 16703.062},
+			{128e-8, 6.043, 3337.089},
+			{128e-8, 0.630, 1059.382},
+			{127e-8, 1.954, 796.298},
+			{118e-8, 2.998, 2146.165},
+			{88e-8, 3.42, 398.15},
+			{83e-8, 3.86, 3738.76},
+			{76e-8, 4.45, 6151.53},
+			{72e-8, 2.76, 529.69},
+			{67e-8, 2.55, 1751.54},
+			{66e-8, 4.41, 1748.02},
+			{58e-8, 0.54, 1194.45},
+			{54e-8, 0.68, 8962.46},
+			{51e-8, 3.73, 6684.75},
+			{49e-8, 5.73, 3340.60},
+			{49e-8, 1.48, 3340.63},
+			{48e-8, 2.58, 3149.16},
+			{48e-8, 2.29, 2914.01},
+			{39e-8, 2.32, 4136.91},
+		},
+		{ // R2
+			{44242e-8, 0.47931, 3340.61243},
+			{8138e-8, 0.8700, 6681.2249},
+			{1275e-8, 1.2259, 10021.8373},
+			{187e-8, 1.573, 13362.450},
+			{52e-8, 3.14, 0},
+			{41e-8, 1.97, 3344.14},
+			{27e-8, 1.92, 16703.06},
+			{18e-8, 4.43, 2281.23},
+			{12e-8, 4.53, 3185.19},
+			{10e-8, 5.39, 1059.38},
+			{10e-8, 0.42, 796.30},
+		},
+		{ // R3
+			{1113e-8, 5.1499, 3340.6124},
+			{424e-8, 5.613, 6681.225},
+			{100e-8, 5.997, 10021.837},
+			{20e-8, 0.08, 13362.45},
+			{5e-8, 3.14, 0},
+			{3e-8, 0.43, 16703.06},
+		},
+		{ // R4
+			{20e-8, 3.58, 3340.61},
+			{16e-8, 4.05, 6681.22},
+			{6e-8, 4.46, 10021.84},
+			{2e-8, 4.84, 13362.45},
+		},
+	},
+}
@@ -0,0 +1,198 @@
+package vsop87
+
+// Mercury, heliocentric ecliptic coordinates referred to the mean equinox
+// of the date, truncated according to J.Meeus, "Astronomical Algorithms",
+// Appendix III.
+var Mercury = Planet{
+	L: Series{
+		{ // L0
+			{440250710e-8, 0, 0},
+			{40989415e-8, 1.48302034, 26087.90314157},
+			{5046294e-8, 4.4778549, 52175.8062831},
+			{855347e-8, 1.165203, 78263.709425},
+			{165590e-8, 4.119692, 104351.612566},
+			{34562e-8, 0.77931, 130439.51571},
+			{7583e-8, 3.7135, 156527.4188},
+			{3560e-8, 1.5120, 1109.3786},
+			{1803e-8, 4.1033, 5661.3320},
+			{1726e-8, 0.3583, 182615.3220},
+			{1590e-8, 2.9951, 25028.5212},
+			{1365e-8, 4.5992, 27197.2817},
+			{1017e-8, 0.8803, 31749.2352},
+			{714e-8, 1.541, 24978.525},
+			{644e-8, 5.303, 21535.950},
+			{451e-8, 6.050, 51116.424},
+			{404e-8, 3.282, 208703.225},
+			{352e-8, 5.242, 20426.571},
+			{345e-8, 2.792, 15874.618},
+			{343e-8, 5.765, 955.600},
+			{339e-8, 5.863, 25558.212},
+			{325e-8, 1.337, 53285.185},
+			{273e-8, 2.495, 529.691},
+			{264e-8, 3.917, 57837.138},
+			{260e-8, 0.987, 4551.953},
+			{239e-8, 0.113, 1059.382},
+			{235e-8, 0.267, 11322.664},
+			{217e-8, 0.660, 13521.751},
+			{209e-8, 2.092, 47623.853},
+			{183e-8, 2.629, 27043.503},
+			{182e-8, 2.434, 25661.305},
+			{176e-8, 4.536, 51066.428},
+			{173e-8, 2.452, 24498.830},
+			{142e-8, 3.360, 37410.567},
+			{138e-8, 0.291, 10213.286},
+			{125e-8, 3.721, 39609.655},
+			{118e-8, 2.781, 77204.327},
+			{106e-8, 4.206, 19804.827},
+		},
+		{ // L1
+			{2608814706223e-8, 0, 0},
+			{1126008e-8, 6.2170397, 26087.9031416},
+			{303471e-8, 3.055655, 52175.806283},
+			{80538e-8, 6.10455, 78263.70942},
+			{21245e-8, 2.83532, 104351.61257},
+			{5592e-8, 5.8268, 130439.5157},
+			{1472e-8, 2.5185, 156527.4188},
+			{388e-8, 5.480, 182615.322},
+			{352e-8, 3.052, 1109.379},
+			{103e-8, 2.149, 24978.525},
+			{94e-8, 6.12, 27197.28},
+			{91e-8, 0.00, 25028.52},
+			{52e-8, 5.62, 5661.33},
+			{44e-8, 4.57, 208703.23},
+			{28e-8, 3.04, 51066.43},
+			{27e-8, 5.09, 234791.13},
+		},
+		{ // L2
+			{53050e-8, 0, 0},
+			{16904e-8, 4.69072, 26087.90314},
+			{7397e-8, 1.3474, 52175.8063},
+			{3018e-8, 4.4564, 78263.7094},
+			{1107e-8, 1.2623, 104351.6126},
+			{378e-8, 4.320, 130439.516},
+			{123e-8, 1.069, 156527.419},
+			{39e-8, 4.08, 182615.32},
+			{15e-8, 4.63, 1109.38},
+			{12e-8, 0.79, 208703.23},
+		},
+		{ // L3
+			{188e-8, 0.035, 52175.806},
+			{142e-8, 3.125, 26087.903},
+			{97e-8, 3.00, 78263.71},
+			{44e-8, 6.02, 104351.61},
+			{35e-8, 0, 0},
+			{18e-8, 2.78, 130439.52},
+			{7e-8, 5.82, 156527.42},
+			{3e-8, 2.57, 182615.32},
+		},
+		{ // L4
+			{114e-8, 3.1416, 0},
+			{2e-8, 2.03, 26087.90},
+			{2e-8, 1.42, 78263.71},
+			{2e-8, 4.50, 52175.81},
+			{1e-8, 4.50, 104351.61},
+			{1e-8, 1.27, 130439.52},
+		},
+		{ // L5
+			{1e-8, 3.14, 0},
+		},
+	},
+	B: Series{
+		{ // B0
+			{11737529e-8, 1.98357499, 26087.90314157},
+			{2388077e-8, 5.0373896, 52175.8062831},
+			{1222840e-8, 3.1415927, 0},
+			{543252e-8, 1.796444, 78263.709425},
+			{129779e-8, 4.832325, 104351.612566},
+			{31867e-8, 1.58088, 130439.51571},
+			{7963e-8, 4.6097, 156527.4188},
+			{2014e-8, 1.3532, 182615.3220},
+			{514e-8, 4.378, 208703.225},
+			{209e-8, 2.020, 24978.525},
+			{208e-8, 4.918, 27197.282},
+			{132e-8, 1.119, 234791.128},
+			{121e-8, 1.813, 53285.185},
+			{100e-8, 5.657, 20426.571},
+		},
+		{ // B1
+			{429151e-8, 3.501698, 26087.903142},
+			{146234e-8, 3.141593, 0},
+			{22675e-8, 0.01515, 52175.80628},
+			{10895e-8, 0.48540, 78263.70942},
+			{6353e-8, 3.4294, 104351.6126},
+			{2496e-8, 0.1605, 130439.5157},
+			{860e-8, 3.185, 156527.419},
+			{278e-8, 6.210, 182615.322},
+			{86e-8, 2.95, 208703.23},
+			{28e-8, 0.29, 27197.28},
+			{26e-8, 5.98, 234791.13},
+		},
+		{ // B2
+			{11831e-8, 4.79066, 26087.90314},
+			{1914e-8, 0, 0},
+			{1045e-8, 1.2122, 52175.8063},
+			{266e-8, 4.434, 78263.709},
+			{170e-8, 1.623, 104351.613},
+			{96e-8, 4.80, 130439.52},
+			{45e-8, 1.61, 156527.42},
+			{18e-8, 4.67, 182615.32},
+			{7e-8, 1.43, 208703.23},
+		},
+		{ // B3
+			{235e-8, 0.354, 26087.903},
+			{161e-8, 0, 0},
+			{19e-8, 4.36, 52175.81},
+			{6e-8, 2.51, 78263.71},
+			{5e-8, 6.14, 104351.61},
+			{3e-8, 3.12, 130439.52},
+			{2e-8, 6.27, 156527.42},
+		},
+		{ // B4
+			{4e-8, 1.75, 26087.90},
+			{1e-8, 3.14, 0},
+		},
+	},
+	R: Series{
+		{ // R0
+			{39528272e-8, 0, 0},
+			{7834132e-8, 6.1923372, 26087.9031416},
+			{795526e-8, 2.959897, 52175.806283},
+			{121282e-8, 6.010642, 78263.709425},
+			{21922e-8, 2.77820, 104351.61257},
+			{4354e-8, 5.8289, 130439.5157},
+			{918e-8, 2.597, 156527.419},
+			{290e-8, 1.424, 25028.521},
+			{260e-8, 3.028, 27197.282},
+			{202e-8, 5.647, 182615.322},
+			{201e-8, 5.592, 31749.235},
+			{142e-8, 6.253, 24978.525},
+			{100e-8, 3.734, 21535.950},
+		},
+		{ // R1
+			{217348e-8, 4.656172, 26087.903142},
+			{44142e-8, 1.42386, 52175.80628},
+			{10094e-8, 4.47466, 78263.70942},
+			{2433e-8, 1.2423, 104351.6126},
+			{1624e-8, 0, 0},
+			{604e-8, 4.293, 130439.516},
+			{153e-8, 1.061, 156527.419},
+			{39e-8, 4.11, 182615.32},
+		},
+		{ // R2
+			{3118e-8, 3.0823, 26087.9031},
+			{1245e-8, 6.1518, 52175.8063},
+			{425e-8, 2.926, 78263.709},
+			{136e-8, 5.980, 104351.613},
+			{42e-8, 2.75, 130439.52},
+			{22e-8, 3.14, 0},
+			{13e-8, 5.80, 156527.42},
+		},
+		{ // R3
+			{33e-8, 1.68, 26087.90},
+			{24e-8, 4.63, 52175.81},
+			{12e-8, 1.39, 78263.71},
+			{5e-8, 4.44, 104351.61},
+			{2e-8, 1.21, 130439.52},
+		},
+	},
+}
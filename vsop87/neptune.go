@@ -0,0 +1,203 @@
+package vsop87
+
+// Neptune, heliocentric ecliptic coordinates referred to the mean equinox
+// of the date, truncated according to J.Meeus, "Astronomical Algorithms",
+// Appendix III.
+var Neptune = Planet{
+	L: Series{
+		{ // L0
+			{531188633e-8, 0, 0},
+			{1798476e-8, 2.9010127, 38.1330356},
+			{1019728e-8, 0.4858092, 1.4844727},
+			{124532e-8, 4.830081, 36.648563},
+			{42064e-8, 5.41055, 2.96895},
+			{37715e-8, 6.09222, 35.16409},
+			{33785e-8, 1.24489, 76.26607},
+			{16483e-8, 0.00008, 491.55793},
+			{9199e-8, 4.9375, 39.6175},
+			{8994e-8, 0.2746, 175.1661},
+			{4216e-8, 1.9871, 73.2971},
+			{3365e-8, 1.0359, 33.6796},
+			{2285e-8, 4.2061, 4.4534},
+			{1434e-8, 2.7834, 74.7816},
+			{900e-8, 2.076, 109.946},
+			{745e-8, 3.190, 71.813},
+			{506e-8, 5.748, 114.399},
+			{400e-8, 0.350, 1021.249},
+			{345e-8, 3.462, 41.102},
+			{340e-8, 3.304, 77.751},
+			{323e-8, 2.248, 32.165},
+			{306e-8, 0.497, 0.521},
+			{287e-8, 4.505, 0.048},
+			{282e-8, 2.246, 146.594},
+			{267e-8, 4.889, 0.963},
+			{252e-8, 5.782, 388.465},
+			{245e-8, 1.247, 9.561},
+			{233e-8, 2.505, 137.033},
+			{227e-8, 1.797, 453.425},
+			{170e-8, 3.324, 108.461},
+			{151e-8, 2.192, 33.940},
+			{150e-8, 2.997, 5.938},
+			{148e-8, 0.859, 111.430},
+			{119e-8, 3.677, 2.448},
+			{109e-8, 2.416, 183.243},
+			{103e-8, 0.041, 0.261},
+			{103e-8, 4.404, 70.328},
+			{102e-8, 5.705, 0.112},
+		},
+		{ // L1
+			{3837687717e-8, 0, 0},
+			{16604e-8, 4.86319, 1.48447},
+			{15807e-8, 2.27923, 38.13304},
+			{3335e-8, 3.6820, 76.2661},
+			{1306e-8, 3.6732, 2.9689},
+			{605e-8, 1.505, 35.164},
+			{179e-8, 3.453, 39.618},
+			{107e-8, 2.451, 4.453},
+			{106e-8, 2.755, 33.680},
+			{73e-8, 5.49, 36.65},
+			{57e-8, 1.86, 114.40},
+			{57e-8, 5.22, 0.52},
+			{35e-8, 4.52, 74.78},
+			{32e-8, 5.90, 77.75},
+			{30e-8, 3.67, 388.47},
+			{29e-8, 5.17, 9.56},
+			{29e-8, 5.17, 2.45},
+			{26e-8, 5.25, 168.05},
+		},
+		{ // L2
+			{53893e-8, 0, 0},
+			{296e-8, 1.855, 1.484},
+			{281e-8, 1.191, 38.133},
+			{270e-8, 5.721, 76.266},
+			{23e-8, 1.21, 2.97},
+			{9e-8, 4.43, 35.16},
+			{7e-8, 0.54, 2.45},
+		},
+		{ // L3
+			{31e-8, 0, 0},
+			{15e-8, 1.35, 76.27},
+			{12e-8, 6.04, 1.48},
+			{12e-8, 6.11, 38.13},
+		},
+		{ // L4
+			{114e-8, 3.142, 0},
+		},
+	},
+	B: Series{
+		{ // B0
+			{3088623e-8, 1.4410437, 38.1330356},
+			{27780e-8, 5.91272, 76.26607},
+			{27624e-8, 0, 0},
+			{15448e-8, 3.50877, 39.61751},
+			{15355e-8, 2.52124, 36.64856},
+			{2000e-8, 1.5100, 74.7816},
+			{1968e-8, 4.3778, 1.4845},
+			{1015e-8, 3.2156, 35.1641},
+			{606e-8, 2.802, 73.297},
+			{595e-8, 2.129, 41.102},
+			{589e-8, 3.187, 2.969},
+			{402e-8, 4.169, 114.399},
+			{280e-8, 1.682, 77.751},
+			{262e-8, 3.767, 213.299},
+			{254e-8, 3.271, 453.425},
+			{206e-8, 4.257, 529.691},
+			{140e-8, 3.530, 137.033},
+		},
+		{ // B1
+			{227279e-8, 3.807931, 38.133036},
+			{1803e-8, 1.9758, 76.2661},
+			{1433e-8, 3.1416, 0},
+			{1386e-8, 4.8256, 36.6486},
+			{1073e-8, 6.0805, 39.6175},
+			{148e-8, 3.858, 74.782},
+			{136e-8, 0.478, 1.484},
+			{70e-8, 6.19, 35.16},
+			{52e-8, 5.05, 73.30},
+			{43e-8, 0.31, 114.40},
+			{37e-8, 4.89, 41.10},
+			{37e-8, 5.76, 2.97},
+			{26e-8, 5.22, 213.30},
+		},
+		{ // B2
+			{9691e-8, 5.5712, 38.1330},
+			{79e-8, 3.63, 76.27},
+			{72e-8, 0.45, 36.65},
+			{59e-8, 3.14, 0},
+			{30e-8, 1.61, 39.62},
+			{6e-8, 5.61, 74.78},
+		},
+		{ // B3
+			{273e-8, 1.017, 38.133},
+			{2e-8, 0, 0},
+			{2e-8, 2.37, 36.65},
+			{2e-8, 5.33, 39.62},
+		},
+		{ // B4
+			{6e-8, 2.67, 38.13},
+		},
+	},
+	R: Series{
+		{ // R0
+			{3007013206e-8, 0, 0},
+			{27062259e-8, 1.32999459, 38.13303564},
+			{1691764e-8, 3.2518614, 36.6485629},
+			{807831e-8, 5.185928, 1.484473},
+			{537761e-8, 4.521139, 35.164090},
+			{495726e-8, 1.571057, 491.557929},
+			{274572e-8, 1.845523, 175.166060},
+			{135134e-8, 3.372206, 39.617508},
+			{121802e-8, 5.797544, 76.266071},
+			{100895e-8, 0.377027, 73.297126},
+			{69792e-8, 3.79617, 2.96895},
+			{46688e-8, 5.74938, 33.67962},
+			{24594e-8, 0.50802, 109.94569},
+			{16939e-8, 1.59422, 71.81265},
+			{14230e-8, 1.07786, 74.78160},
+			{12012e-8, 1.92062, 1021.24889},
+			{8395e-8, 0.6782, 146.5943},
+			{7572e-8, 1.0715, 388.4652},
+			{5721e-8, 2.5906, 4.4534},
+			{4840e-8, 1.9069, 41.1020},
+			{4483e-8, 2.9057, 529.6910},
+			{4421e-8, 1.7499, 108.4612},
+			{4354e-8, 0.6799, 32.1648},
+			{4270e-8, 3.4134, 453.4249},
+			{3381e-8, 0.8481, 183.2428},
+			{2881e-8, 1.9860, 137.0330},
+			{2879e-8, 3.6742, 350.3321},
+			{2636e-8, 3.0976, 213.2991},
+			{2530e-8, 5.7984, 490.0735},
+			{2523e-8, 0.4863, 493.0424},
+			{2306e-8, 2.8096, 70.3282},
+			{2087e-8, 0.6186, 33.9402},
+		},
+		{ // R1
+			{236339e-8, 0.704980, 38.133036},
+			{13220e-8, 3.32015, 1.48447},
+			{8622e-8, 6.2163, 35.1641},
+			{2702e-8, 1.8814, 39.6175},
+			{2155e-8, 2.0943, 2.9689},
+			{2153e-8, 5.1687, 76.2661},
+			{1603e-8, 0, 0},
+			{1464e-8, 1.1842, 33.6796},
+			{1136e-8, 3.9189, 36.6486},
+			{898e-8, 5.241, 388.465},
+			{790e-8, 0.533, 168.053},
+			{760e-8, 0.021, 182.280},
+			{607e-8, 1.077, 1021.249},
+			{572e-8, 3.401, 484.444},
+			{561e-8, 2.887, 498.671},
+		},
+		{ // R2
+			{4247e-8, 5.8991, 38.1330},
+			{218e-8, 0.346, 1.484},
+			{163e-8, 2.239, 168.053},
+			{156e-8, 4.594, 182.280},
+			{127e-8, 2.848, 35.164},
+		},
+		{ // R3
+			{166e-8, 4.552, 38.133},
+		},
+	},
+}
@@ -0,0 +1,681 @@
+package vsop87
+
+// Saturn, heliocentric ecliptic coordinates referred to the mean equinox
+// of the date, truncated according to J.Meeus, "Astronomical Algorithms",
+// Appendix III.
+var Saturn = Planet{
+	L: Series{
+		{ // L0
+			{87401354e-8, 0, 0},
+			{11107660e-8, 3.96205090, 213.29909544},
+			{1414151e-8, 4.5858152, 7.1135470},
+			{398379e-8, 0.521120, 206.185548},
+			{350769e-8, 3.303299, 426.598191},
+			{206816e-8, 0.246584, 103.092774},
+			{79271e-8, 3.84007, 220.41264},
+			{23990e-8, 4.66977, 110.20632},
+			{16574e-8, 0.43719, 419.48464},
+			{15820e-8, 0.93809, 632.78374},
+			{15054e-8, 2.71670, 639.89729},
+			{14907e-8, 5.76903, 316.39187},
+			{14610e-8, 1.56519, 3.93215},
+			{13160e-8, 4.44891, 14.22709},
+			{13005e-8, 5.98119, 11.04570},
+			{10725e-8, 3.12940, 202.25340},
+			{6126e-8, 1.7633, 277.0350},
+			{5863e-8, 0.2366, 529.6910},
+			{5228e-8, 4.2078, 3.1814},
+			{5020e-8, 3.1779, 433.7117},
+			{4593e-8, 0.6198, 199.0720},
+			{4006e-8, 2.2448, 63.7359},
+			{3874e-8, 3.2228, 138.5175},
+			{3269e-8, 0.7749, 949.1756},
+			{2954e-8, 0.9828, 95.9792},
+			{2461e-8, 2.0316, 735.8765},
+			{1758e-8, 3.2658, 522.5774},
+			{1640e-8, 5.5050, 846.0828},
+			{1581e-8, 4.3727, 309.2783},
+			{1391e-8, 4.0233, 323.5054},
+			{1124e-8, 2.8373, 415.5525},
+			{1087e-8, 4.1834, 2.4477},
+			{1017e-8, 3.7170, 227.5262},
+			{957e-8, 0.507, 1265.567},
+			{853e-8, 3.421, 175.166},
+			{849e-8, 3.191, 209.367},
+			{789e-8, 5.007, 0.963},
+			{749e-8, 2.144, 853.196},
+			{744e-8, 5.253, 224.345},
+			{687e-8, 1.747, 1052.268},
+			{654e-8, 1.599, 0.048},
+			{634e-8, 2.299, 412.371},
+			{625e-8, 0.970, 210.118},
+			{580e-8, 3.093, 74.782},
+			{546e-8, 2.127, 350.332},
+			{543e-8, 1.518, 9.561},
+			{530e-8, 4.449, 117.320},
+			{478e-8, 2.965, 137.033},
+			{474e-8, 5.475, 742.990},
+			{452e-8, 1.044, 490.334},
+			{449e-8, 1.290, 127.472},
+			{372e-8, 2.278, 217.231},
+			{355e-8, 3.013, 838.969},
+			{347e-8, 1.539, 340.771},
+			{343e-8, 0.246, 0.521},
+			{330e-8, 0.247, 1581.959},
+			{322e-8, 0.961, 203.738},
+			{322e-8, 2.572, 647.011},
+			{309e-8, 3.495, 216.480},
+			{287e-8, 2.370, 351.817},
+			{278e-8, 0.400, 211.815},
+			{249e-8, 1.470, 1368.660},
+			{227e-8, 4.910, 12.530},
+			{220e-8, 4.204, 200.769},
+			{209e-8, 1.345, 625.670},
+			{208e-8, 0.483, 1162.475},
+			{208e-8, 1.283, 39.357},
+			{204e-8, 6.011, 265.989},
+			{185e-8, 3.503, 149.563},
+			{184e-8, 0.973, 4.193},
+			{182e-8, 5.491, 2.921},
+			{174e-8, 1.863, 0.751},
+			{165e-8, 0.440, 5.417},
+			{149e-8, 5.736, 52.690},
+			{148e-8, 1.535, 5.629},
+			{146e-8, 6.231, 195.140},
+			{140e-8, 4.295, 21.341},
+			{131e-8, 4.068, 10.295},
+			{125e-8, 6.277, 1898.351},
+			{122e-8, 1.976, 4.666},
+			{118e-8, 5.341, 554.070},
+			{117e-8, 2.679, 1155.361},
+			{114e-8, 5.594, 1059.382},
+			{112e-8, 1.105, 191.208},
+			{110e-8, 0.166, 1.484},
+			{109e-8, 3.438, 536.805},
+			{107e-8, 4.012, 956.289},
+			{104e-8, 2.192, 88.866},
+			{103e-8, 1.197, 1685.052},
+			{101e-8, 4.965, 269.921},
+		},
+		{ // L1
+			{21354295596e-8, 0, 0},
+			{1296855e-8, 1.8282054, 213.2990954},
+			{564348e-8, 2.885001, 7.113547},
+			{107679e-8, 2.277699, 206.185548},
+			{98323e-8, 1.08070, 426.59819},
+			{40255e-8, 2.04128, 220.41264},
+			{19942e-8, 1.27955, 103.09277},
+			{10512e-8, 2.74880, 14.22709},
+			{6939e-8, 0.4049, 639.8973},
+			{4803e-8, 2.4419, 419.4846},
+			{4056e-8, 2.9217, 110.2063},
+			{3769e-8, 3.6497, 3.9322},
+			{3385e-8, 2.4169, 3.1814},
+			{3302e-8, 1.2626, 433.7117},
+			{3071e-8, 2.3274, 199.0720},
+			{1953e-8, 3.5639, 11.0457},
+			{1249e-8, 2.6280, 95.9792},
+			{922e-8, 1.961, 227.526},
+			{706e-8, 4.417, 529.691},
+			{650e-8, 6.174, 202.253},
+			{628e-8, 6.111, 309.278},
+			{487e-8, 6.040, 853.196},
+			{479e-8, 4.988, 522.577},
+			{468e-8, 4.617, 63.736},
+			{417e-8, 2.117, 323.505},
+			{408e-8, 1.299, 209.367},
+			{352e-8, 2.317, 632.784},
+			{344e-8, 3.959, 412.371},
+			{340e-8, 3.634, 316.392},
+			{336e-8, 3.772, 735.877},
+			{332e-8, 2.861, 210.118},
+			{289e-8, 2.733, 117.320},
+			{281e-8, 5.744, 2.448},
+			{266e-8, 0.543, 647.011},
+			{230e-8, 1.644, 216.480},
+			{192e-8, 2.965, 224.345},
+			{173e-8, 4.077, 846.083},
+			{167e-8, 2.597, 21.341},
+			{136e-8, 2.286, 10.295},
+			{131e-8, 3.441, 742.990},
+			{128e-8, 4.095, 217.231},
+			{109e-8, 6.161, 415.552},
+			{98e-8, 4.73, 838.97},
+			{94e-8, 3.48, 1052.27},
+			{92e-8, 3.95, 88.87},
+			{87e-8, 1.22, 440.83},
+			{83e-8, 3.11, 625.67},
+			{78e-8, 6.24, 302.16},
+			{67e-8, 0.29, 4.67},
+			{66e-8, 5.65, 9.56},
+			{62e-8, 4.29, 127.47},
+			{62e-8, 1.83, 195.14},
+			{58e-8, 2.48, 191.96},
+			{57e-8, 5.02, 137.03},
+			{55e-8, 0.28, 74.78},
+			{54e-8, 5.13, 490.33},
+			{51e-8, 1.46, 536.80},
+			{47e-8, 1.18, 149.56},
+			{47e-8, 5.15, 515.46},
+			{46e-8, 2.23, 956.29},
+			{44e-8, 2.71, 5.42},
+			{40e-8, 0.41, 269.92},
+			{40e-8, 3.89, 728.76},
+			{38e-8, 0.65, 422.67},
+			{38e-8, 2.53, 12.53},
+			{37e-8, 3.78, 2.92},
+			{35e-8, 6.08, 5.63},
+			{34e-8, 3.21, 1368.66},
+			{33e-8, 4.64, 277.03},
+			{33e-8, 5.43, 1066.50},
+			{33e-8, 0.30, 351.82},
+			{32e-8, 4.39, 1155.36},
+			{31e-8, 2.43, 52.69},
+			{30e-8, 2.84, 203.00},
+			{30e-8, 6.19, 284.15},
+			{30e-8, 3.39, 1059.38},
+			{29e-8, 2.03, 330.62},
+			{28e-8, 2.74, 265.99},
+			{26e-8, 4.51, 340.77},
+		},
+		{ // L2
+			{116441e-8, 1.179879, 7.113547},
+			{91921e-8, 0.07425, 213.29910},
+			{90592e-8, 0, 0},
+			{15277e-8, 4.06492, 206.18555},
+			{10631e-8, 0.25778, 220.41264},
+			{10605e-8, 5.40964, 426.59819},
+			{4265e-8, 1.0460, 14.2271},
+			{1216e-8, 2.9186, 103.0928},
+			{1165e-8, 4.6094, 639.8973},
+			{1082e-8, 5.6913, 433.7117},
+			{1045e-8, 4.0421, 199.0720},
+			{1020e-8, 0.6337, 3.1814},
+			{634e-8, 4.388, 419.485},
+			{549e-8, 5.573, 3.932},
+			{457e-8, 1.268, 110.206},
+			{425e-8, 0.209, 227.526},
+			{274e-8, 4.288, 95.979},
+			{162e-8, 1.381, 11.046},
+			{129e-8, 1.566, 309.278},
+			{117e-8, 3.881, 853.196},
+			{105e-8, 4.900, 647.011},
+			{101e-8, 0.893, 21.341},
+			{96e-8, 2.91, 316.39},
+			{95e-8, 5.63, 412.37},
+			{85e-8, 5.73, 209.37},
+			{83e-8, 6.05, 216.48},
+			{82e-8, 1.02, 117.32},
+			{75e-8, 4.76, 210.12},
+			{67e-8, 0.46, 522.58},
+			{66e-8, 0.48, 10.29},
+			{64e-8, 0.35, 323.51},
+			{61e-8, 4.88, 632.78},
+			{53e-8, 2.75, 529.69},
+			{46e-8, 5.69, 440.83},
+			{45e-8, 1.67, 202.25},
+			{42e-8, 5.71, 88.87},
+			{32e-8, 0.07, 63.74},
+			{32e-8, 1.67, 302.16},
+			{31e-8, 4.16, 191.96},
+			{27e-8, 0.83, 224.34},
+			{25e-8, 5.66, 735.88},
+			{20e-8, 5.94, 217.23},
+			{18e-8, 4.90, 625.67},
+			{17e-8, 1.63, 742.99},
+			{16e-8, 0.58, 515.46},
+			{14e-8, 0.21, 838.97},
+			{14e-8, 3.76, 195.14},
+			{12e-8, 4.72, 203.00},
+			{12e-8, 0.13, 234.64},
+			{12e-8, 3.12, 846.08},
+			{11e-8, 5.92, 536.80},
+			{11e-8, 5.60, 728.76},
+			{11e-8, 3.20, 1066.50},
+			{10e-8, 4.99, 422.67},
+			{10e-8, 0.26, 330.62},
+			{10e-8, 4.15, 860.31},
+			{9e-8, 0.46, 956.29},
+			{8e-8, 2.14, 269.92},
+			{8e-8, 5.25, 429.78},
+			{8e-8, 4.03, 9.56},
+			{7e-8, 5.40, 1052.27},
+			{6e-8, 4.46, 284.15},
+			{6e-8, 5.93, 405.29},
+		},
+		{ // L3
+			{16039e-8, 5.73945, 7.11355},
+			{4250e-8, 4.5854, 213.2991},
+			{1907e-8, 4.7608, 220.4126},
+			{1466e-8, 5.9133, 206.1855},
+			{1162e-8, 5.6197, 14.2271},
+			{1067e-8, 3.6082, 426.5982},
+			{239e-8, 3.861, 433.712},
+			{237e-8, 5.768, 199.072},
+			{166e-8, 5.116, 3.181},
+			{151e-8, 2.736, 639.897},
+			{131e-8, 4.743, 227.526},
+			{63e-8, 0.23, 419.48},
+			{62e-8, 4.74, 103.09},
+			{40e-8, 5.47, 21.34},
+			{40e-8, 5.96, 95.98},
+			{39e-8, 5.83, 110.21},
+			{28e-8, 3.01, 647.01},
+			{25e-8, 0.99, 3.93},
+			{19e-8, 1.92, 853.20},
+			{18e-8, 4.97, 10.29},
+			{18e-8, 1.03, 412.37},
+			{18e-8, 4.20, 216.48},
+			{18e-8, 3.32, 309.28},
+			{16e-8, 3.90, 440.83},
+			{16e-8, 5.62, 117.32},
+			{13e-8, 1.18, 88.87},
+			{11e-8, 5.58, 11.05},
+			{11e-8, 5.93, 191.96},
+			{10e-8, 3.95, 209.37},
+			{9e-8, 3.39, 302.16},
+			{8e-8, 4.88, 323.51},
+			{7e-8, 0.38, 632.78},
+			{6e-8, 2.25, 522.58},
+			{6e-8, 1.06, 210.12},
+			{5e-8, 4.64, 234.64},
+			{4e-8, 3.14, 0},
+			{4e-8, 2.31, 515.46},
+			{3e-8, 2.20, 860.31},
+			{3e-8, 0.59, 529.69},
+			{3e-8, 4.93, 224.34},
+			{3e-8, 0.42, 625.67},
+			{2e-8, 4.77, 330.62},
+			{2e-8, 3.35, 429.78},
+			{2e-8, 3.20, 202.25},
+			{2e-8, 1.19, 1066.50},
+			{2e-8, 1.35, 405.29},
+			{2e-8, 4.16, 223.59},
+			{2e-8, 3.07, 654.12},
+		},
+		{ // L4
+			{1662e-8, 3.9983, 7.1135},
+			{257e-8, 2.984, 220.413},
+			{236e-8, 3.902, 14.227},
+			{149e-8, 2.741, 213.299},
+			{114e-8, 3.142, 0},
+			{110e-8, 1.515, 206.186},
+			{68e-8, 1.72, 426.60},
+			{40e-8, 2.05, 433.71},
+			{38e-8, 1.24, 199.07},
+			{31e-8, 3.01, 227.53},
+			{15e-8, 0.83, 639.90},
+			{9e-8, 3.71, 21.34},
+			{6e-8, 2.42, 419.48},
+			{6e-8, 1.16, 647.01},
+			{4e-8, 1.45, 95.98},
+			{4e-8, 2.12, 440.83},
+			{3e-8, 4.09, 110.21},
+			{3e-8, 2.77, 412.37},
+			{3e-8, 3.01, 88.87},
+			{3e-8, 0.00, 853.20},
+			{3e-8, 0.39, 103.09},
+			{2e-8, 3.78, 117.32},
+			{2e-8, 2.83, 234.64},
+			{2e-8, 5.08, 309.28},
+			{2e-8, 2.24, 216.48},
+			{2e-8, 5.19, 302.16},
+			{1e-8, 1.55, 191.96},
+		},
+		{ // L5
+			{124e-8, 2.259, 7.114},
+			{34e-8, 2.16, 14.23},
+			{28e-8, 1.20, 220.41},
+			{6e-8, 1.22, 227.53},
+			{5e-8, 0.24, 433.71},
+			{4e-8, 6.23, 426.60},
+			{3e-8, 2.97, 199.07},
+			{3e-8, 4.29, 206.19},
+			{2e-8, 6.25, 213.30},
+			{1e-8, 5.28, 639.90},
+			{1e-8, 0.24, 440.83},
+			{1e-8, 3.14, 0},
+		},
+	},
+	B: Series{
+		{ // B0
+			{4330678e-8, 3.6028443, 213.2990954},
+			{240348e-8, 2.852385, 426.598191},
+			{84746e-8, 0, 0},
+			{34116e-8, 0.57297, 206.18555},
+			{30863e-8, 3.48442, 220.41264},
+			{14734e-8, 2.11847, 639.89729},
+			{9917e-8, 5.7900, 419.4846},
+			{6994e-8, 4.7360, 7.1135},
+			{4808e-8, 5.4331, 316.3919},
+			{4788e-8, 4.9651, 110.2063},
+			{3432e-8, 2.7326, 433.7117},
+			{1506e-8, 6.0130, 103.0928},
+			{1060e-8, 5.6310, 529.6910},
+			{969e-8, 5.204, 632.784},
+			{942e-8, 1.396, 853.196},
+			{708e-8, 3.803, 323.505},
+			{552e-8, 5.131, 202.253},
+			{400e-8, 3.359, 227.526},
+			{319e-8, 3.626, 209.367},
+			{316e-8, 1.997, 647.011},
+			{314e-8, 0.465, 217.231},
+			{284e-8, 4.886, 224.345},
+			{236e-8, 2.139, 11.046},
+			{215e-8, 5.950, 846.083},
+			{209e-8, 2.120, 415.552},
+			{207e-8, 0.730, 199.072},
+			{179e-8, 2.954, 63.736},
+			{141e-8, 0.644, 490.334},
+			{139e-8, 4.595, 14.227},
+			{139e-8, 1.998, 735.877},
+			{135e-8, 5.245, 742.990},
+			{122e-8, 3.115, 522.577},
+			{116e-8, 3.109, 216.480},
+			{114e-8, 0.963, 210.118},
+		},
+		{ // B1
+			{397555e-8, 5.332900, 213.299095},
+			{49479e-8, 3.14159, 0},
+			{18572e-8, 6.09919, 426.59819},
+			{14801e-8, 2.30586, 206.18555},
+			{9644e-8, 1.6967, 220.4126},
+			{3757e-8, 1.2543, 419.4846},
+			{2717e-8, 5.9117, 639.8973},
+			{1455e-8, 0.8516, 433.7117},
+			{1291e-8, 2.9177, 7.1135},
+			{853e-8, 0.436, 316.392},
+			{298e-8, 0.919, 632.784},
+			{292e-8, 5.316, 853.196},
+			{284e-8, 1.619, 227.526},
+			{275e-8, 3.889, 103.093},
+			{172e-8, 0.052, 647.011},
+			{166e-8, 2.444, 199.072},
+			{158e-8, 5.209, 110.206},
+			{128e-8, 1.207, 529.691},
+			{110e-8, 2.457, 217.231},
+			{82e-8, 2.76, 210.12},
+			{81e-8, 2.86, 14.23},
+			{69e-8, 1.66, 202.25},
+			{65e-8, 1.26, 216.48},
+			{61e-8, 1.25, 209.37},
+			{59e-8, 1.82, 323.51},
+			{46e-8, 0.82, 440.83},
+			{36e-8, 1.82, 224.34},
+			{34e-8, 2.84, 117.32},
+			{33e-8, 1.31, 412.37},
+			{32e-8, 1.19, 846.08},
+			{27e-8, 4.65, 1066.50},
+			{27e-8, 4.44, 11.05},
+		},
+		{ // B2
+			{20630e-8, 0.50482, 213.29910},
+			{3720e-8, 3.9983, 206.1855},
+			{1627e-8, 6.1819, 220.4126},
+			{1346e-8, 0, 0},
+			{706e-8, 3.039, 419.485},
+			{365e-8, 5.099, 426.598},
+			{330e-8, 5.279, 433.712},
+			{219e-8, 3.828, 639.897},
+			{139e-8, 1.043, 7.114},
+			{104e-8, 6.157, 227.526},
+			{93e-8, 1.98, 316.39},
+			{71e-8, 4.15, 199.07},
+			{52e-8, 2.88, 632.78},
+			{49e-8, 4.43, 647.01},
+			{41e-8, 3.16, 853.20},
+			{29e-8, 4.53, 210.12},
+			{24e-8, 1.12, 14.23},
+			{21e-8, 4.35, 217.23},
+			{20e-8, 5.31, 440.83},
+			{18e-8, 0.85, 110.21},
+			{17e-8, 5.68, 216.48},
+			{16e-8, 4.26, 103.09},
+			{14e-8, 3.00, 412.37},
+			{12e-8, 2.53, 529.69},
+			{8e-8, 3.32, 202.25},
+			{7e-8, 5.56, 209.37},
+			{7e-8, 0.29, 323.51},
+			{6e-8, 1.16, 117.32},
+			{6e-8, 3.61, 860.31},
+		},
+		{ // B3
+			{666e-8, 1.990, 213.299},
+			{632e-8, 5.698, 206.186},
+			{398e-8, 0, 0},
+			{188e-8, 4.338, 220.413},
+			{92e-8, 4.84, 419.48},
+			{52e-8, 3.42, 433.71},
+			{42e-8, 2.38, 426.60},
+			{26e-8, 4.40, 227.53},
+			{21e-8, 5.85, 199.07},
+			{18e-8, 1.99, 639.90},
+			{11e-8, 5.37, 7.11},
+			{10e-8, 2.55, 647.01},
+			{7e-8, 3.46, 316.39},
+			{6e-8, 4.80, 632.78},
+			{6e-8, 0.02, 210.12},
+			{6e-8, 3.52, 440.83},
+			{5e-8, 5.64, 14.23},
+			{5e-8, 1.22, 853.20},
+			{4e-8, 4.71, 412.37},
+			{3e-8, 0.63, 103.09},
+			{2e-8, 3.72, 216.48},
+		},
+		{ // B4
+			{80e-8, 1.12, 206.19},
+			{32e-8, 3.12, 213.30},
+			{17e-8, 2.48, 220.41},
+			{12e-8, 3.14, 0},
+			{9e-8, 0.38, 419.48},
+			{6e-8, 1.56, 433.71},
+			{5e-8, 2.63, 227.53},
+			{5e-8, 1.28, 199.07},
+			{1e-8, 1.43, 426.60},
+			{1e-8, 0.67, 647.01},
+			{1e-8, 1.72, 440.83},
+			{1e-8, 6.18, 639.90},
+		},
+		{ // B5
+			{8e-8, 2.82, 206.19},
+			{1e-8, 0.51, 220.41},
+		},
+	},
+	R: Series{
+		{ // R0
+			{955758136e-8, 0, 0},
+			{52921382e-8, 2.39226220, 213.29909544},
+			{1873680e-8, 5.2354961, 206.1855484},
+			{1464664e-8, 1.6476305, 426.5981909},
+			{821891e-8, 5.935200, 316.391870},
+			{547507e-8, 5.015326, 103.092774},
+			{371684e-8, 2.271148, 220.412642},
+			{361778e-8, 3.139043, 7.113547},
+			{140618e-8, 5.704067, 632.783739},
+			{108975e-8, 3.293136, 110.206321},
+			{69007e-8, 5.94100, 419.48464},
+			{61053e-8, 0.94038, 639.89729},
+			{48913e-8, 1.55733, 202.25340},
+			{34144e-8, 0.19519, 277.03499},
+			{32402e-8, 5.47085, 949.17561},
+			{20937e-8, 0.46349, 735.87651},
+			{20839e-8, 1.52103, 433.71174},
+			{20747e-8, 5.33256, 199.07200},
+			{15298e-8, 3.05944, 529.69097},
+			{14296e-8, 2.60434, 323.50542},
+			{12884e-8, 1.64892, 138.51750},
+			{11993e-8, 5.98051, 846.08283},
+			{11380e-8, 1.73106, 522.57742},
+			{9796e-8, 5.2048, 1265.5675},
+			{7753e-8, 5.8519, 95.9792},
+			{6771e-8, 3.0043, 14.2271},
+			{6466e-8, 0.1773, 1052.2684},
+			{5850e-8, 1.4552, 415.5525},
+			{5307e-8, 0.5974, 63.7359},
+			{4696e-8, 2.1492, 227.5262},
+			{4044e-8, 1.6401, 209.3669},
+			{3688e-8, 0.7802, 412.3711},
+			{3461e-8, 1.8509, 175.1661},
+			{3420e-8, 4.9455, 1581.9593},
+			{3401e-8, 0.5539, 350.3321},
+			{3376e-8, 3.6953, 224.3448},
+			{2976e-8, 5.6847, 210.1177},
+			{2885e-8, 1.3876, 838.9693},
+			{2881e-8, 0.1796, 853.1964},
+			{2508e-8, 3.5385, 742.9901},
+			{2448e-8, 6.1841, 1368.6603},
+			{2406e-8, 2.9656, 117.3199},
+			{2174e-8, 0.0151, 340.7709},
+			{2024e-8, 5.0541, 11.0457},
+		},
+		{ // R1
+			{6182981e-8, 0.2584352, 213.2990954},
+			{506578e-8, 0.711147, 206.185548},
+			{341394e-8, 5.796358, 426.598191},
+			{188491e-8, 0.472157, 220.412642},
+			{186262e-8, 3.141593, 0},
+			{143891e-8, 1.407449, 7.113547},
+			{49621e-8, 6.01744, 103.09277},
+			{20928e-8, 5.09246, 639.89729},
+			{19953e-8, 1.17560, 419.48464},
+			{18840e-8, 1.60820, 110.20632},
+			{13877e-8, 0.75886, 199.07200},
+			{12893e-8, 5.94330, 433.71174},
+			{5397e-8, 1.2885, 14.2271},
+			{4869e-8, 0.8679, 323.5054},
+			{4247e-8, 0.3930, 227.5262},
+			{3252e-8, 1.2585, 95.9792},
+			{3081e-8, 3.4366, 522.5774},
+			{2909e-8, 4.6068, 202.2534},
+			{2856e-8, 2.1673, 735.8765},
+			{1988e-8, 2.4505, 412.3711},
+			{1941e-8, 6.0239, 209.3669},
+			{1581e-8, 1.2919, 210.1177},
+			{1340e-8, 4.3080, 853.1964},
+			{1316e-8, 1.2530, 117.3199},
+			{1203e-8, 1.8665, 316.3919},
+			{1091e-8, 0.0753, 216.4805},
+			{966e-8, 0.480, 632.784},
+			{954e-8, 5.152, 647.011},
+			{898e-8, 0.983, 529.691},
+			{882e-8, 1.885, 1052.268},
+			{874e-8, 1.402, 224.345},
+			{785e-8, 3.064, 838.969},
+			{740e-8, 1.382, 625.670},
+			{658e-8, 4.144, 309.278},
+			{650e-8, 1.725, 742.990},
+			{613e-8, 3.033, 63.736},
+			{599e-8, 2.549, 217.231},
+			{503e-8, 2.130, 3.932},
+		},
+		{ // R2
+			{436902e-8, 4.786717, 213.299095},
+			{71923e-8, 2.50070, 206.18555},
+			{49767e-8, 4.97168, 220.41264},
+			{43221e-8, 3.86940, 426.59819},
+			{29646e-8, 5.96310, 7.11355},
+			{4721e-8, 2.4753, 199.0720},
+			{4142e-8, 4.1067, 433.7117},
+			{3789e-8, 3.0977, 639.8973},
+			{2964e-8, 1.3721, 103.0928},
+			{2556e-8, 2.8507, 419.4846},
+			{2327e-8, 0, 0},
+			{2208e-8, 6.2759, 110.2063},
+			{2188e-8, 5.8555, 14.2271},
+			{1957e-8, 4.9245, 227.5262},
+			{924e-8, 5.464, 323.505},
+			{706e-8, 2.971, 95.979},
+			{546e-8, 4.129, 412.371},
+			{431e-8, 5.178, 522.577},
+			{405e-8, 4.173, 209.367},
+			{391e-8, 4.481, 216.480},
+			{374e-8, 5.834, 117.320},
+			{361e-8, 3.277, 647.011},
+			{356e-8, 3.192, 210.118},
+			{326e-8, 2.269, 853.196},
+			{207e-8, 4.022, 735.877},
+			{204e-8, 0.088, 202.253},
+			{180e-8, 3.597, 632.784},
+			{178e-8, 4.097, 440.825},
+			{154e-8, 3.135, 625.670},
+			{148e-8, 0.136, 302.165},
+			{133e-8, 2.594, 191.958},
+			{132e-8, 5.933, 309.278},
+		},
+		{ // R3
+			{20315e-8, 3.02187, 213.29910},
+			{8924e-8, 3.1914, 220.4126},
+			{6909e-8, 4.3517, 206.1855},
+			{4087e-8, 4.2241, 7.1135},
+			{3879e-8, 2.0106, 426.5982},
+			{1071e-8, 4.2036, 199.0720},
+			{907e-8, 2.283, 433.712},
+			{606e-8, 3.175, 227.526},
+			{597e-8, 4.135, 14.227},
+			{483e-8, 1.173, 639.897},
+			{393e-8, 0, 0},
+			{229e-8, 4.698, 419.485},
+			{188e-8, 4.590, 110.206},
+			{150e-8, 3.202, 103.093},
+			{121e-8, 3.768, 323.505},
+			{102e-8, 4.710, 95.979},
+			{101e-8, 5.819, 412.371},
+			{93e-8, 1.44, 647.01},
+			{84e-8, 2.63, 216.48},
+			{73e-8, 4.15, 117.32},
+			{62e-8, 2.31, 440.83},
+			{55e-8, 0.31, 853.20},
+			{50e-8, 2.39, 209.37},
+			{45e-8, 4.37, 191.96},
+			{41e-8, 0.69, 522.58},
+			{40e-8, 1.84, 302.16},
+			{38e-8, 5.94, 88.87},
+			{32e-8, 4.01, 21.34},
+		},
+		{ // R4
+			{1202e-8, 1.4150, 220.4126},
+			{708e-8, 1.162, 213.299},
+			{516e-8, 6.240, 206.186},
+			{427e-8, 2.469, 7.114},
+			{268e-8, 0.187, 426.598},
+			{170e-8, 5.959, 199.072},
+			{150e-8, 0.480, 433.712},
+			{145e-8, 1.442, 227.526},
+			{121e-8, 2.405, 14.227},
+			{47e-8, 5.57, 639.90},
+			{19e-8, 5.86, 647.01},
+			{17e-8, 0.53, 440.83},
+			{16e-8, 2.90, 110.21},
+			{15e-8, 0.30, 419.48},
+			{14e-8, 1.30, 412.37},
+			{13e-8, 2.09, 323.51},
+			{11e-8, 0.22, 95.98},
+			{11e-8, 2.46, 117.32},
+			{10e-8, 3.14, 0},
+			{9e-8, 1.56, 88.87},
+			{9e-8, 2.28, 21.34},
+			{9e-8, 0.68, 216.48},
+			{8e-8, 1.27, 234.64},
+		},
+		{ // R5
+			{129e-8, 5.913, 220.413},
+			{32e-8, 0.69, 7.11},
+			{27e-8, 5.91, 227.53},
+			{20e-8, 4.95, 433.71},
+			{20e-8, 0.67, 14.23},
+			{14e-8, 2.67, 206.19},
+			{14e-8, 1.46, 199.07},
+			{13e-8, 4.59, 426.60},
+			{7e-8, 4.63, 213.30},
+			{5e-8, 3.61, 639.90},
+			{4e-8, 4.90, 440.83},
+			{3e-8, 4.07, 647.01},
+			{3e-8, 4.66, 191.96},
+			{3e-8, 0.49, 323.51},
+			{3e-8, 3.18, 419.48},
+			{2e-8, 3.70, 88.87},
+			{2e-8, 3.32, 95.98},
+			{2e-8, 0.56, 117.32},
+		},
+	},
+}
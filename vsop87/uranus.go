@@ -0,0 +1,417 @@
+package vsop87
+
+// Uranus, heliocentric ecliptic coordinates referred to the mean equinox
+// of the date, truncated according to J.Meeus, "Astronomical Algorithms",
+// Appendix III.
+var Uranus = Planet{
+	L: Series{
+		{ // L0
+			{548129294e-8, 0, 0},
+			{9260408e-8, 0.8910642, 74.7815986},
+			{1504248e-8, 3.6271926, 1.4844727},
+			{365982e-8, 1.899622, 73.297126},
+			{272328e-8, 3.358237, 149.563197},
+			{70328e-8, 5.39254, 63.73590},
+			{68893e-8, 6.09292, 76.26607},
+			{61999e-8, 2.26952, 2.96895},
+			{61951e-8, 2.85099, 11.04570},
+			{26469e-8, 3.14152, 71.81265},
+			{25711e-8, 6.11380, 454.90937},
+			{21079e-8, 4.36059, 148.07872},
+			{17819e-8, 1.74437, 36.64856},
+			{14613e-8, 4.73732, 3.93215},
+			{11163e-8, 5.82682, 224.34480},
+			{10998e-8, 0.48865, 138.51750},
+			{9527e-8, 2.9552, 35.1641},
+			{7546e-8, 5.2363, 109.9457},
+			{4220e-8, 3.2333, 70.8494},
+			{4052e-8, 2.2775, 151.0477},
+			{3490e-8, 5.4831, 146.5943},
+			{3355e-8, 1.0655, 4.4534},
+			{3144e-8, 4.7520, 77.7505},
+			{2927e-8, 4.6290, 9.5612},
+			{2922e-8, 5.3524, 85.8273},
+			{2273e-8, 4.3660, 70.3282},
+			{2149e-8, 0.6075, 38.1330},
+			{2051e-8, 1.5177, 0.1119},
+			{1992e-8, 4.9244, 277.0350},
+			{1667e-8, 3.6274, 380.1278},
+			{1533e-8, 2.5859, 52.6902},
+			{1376e-8, 2.0428, 65.2204},
+			{1372e-8, 4.1964, 111.4302},
+			{1284e-8, 3.1135, 202.2534},
+			{1282e-8, 0.5427, 222.8603},
+			{1244e-8, 0.9161, 2.4477},
+			{1221e-8, 0.1990, 108.4612},
+			{1151e-8, 4.1790, 33.6796},
+			{1150e-8, 0.9334, 3.1814},
+			{1090e-8, 1.7750, 12.5302},
+			{1072e-8, 0.2356, 62.2514},
+			{946e-8, 1.192, 127.472},
+			{708e-8, 5.183, 213.299},
+			{653e-8, 0.966, 78.714},
+			{628e-8, 0.182, 984.600},
+			{607e-8, 5.432, 529.691},
+			{559e-8, 3.358, 0.521},
+			{524e-8, 2.013, 299.126},
+			{483e-8, 2.106, 0.963},
+			{471e-8, 1.407, 184.727},
+			{467e-8, 0.415, 145.110},
+			{434e-8, 5.521, 183.243},
+			{405e-8, 5.987, 8.077},
+			{399e-8, 0.338, 415.552},
+			{396e-8, 5.870, 351.817},
+			{379e-8, 2.350, 56.622},
+			{310e-8, 5.833, 145.631},
+			{300e-8, 5.644, 22.091},
+			{294e-8, 5.839, 39.618},
+			{252e-8, 1.637, 221.376},
+			{249e-8, 4.746, 225.829},
+			{239e-8, 2.350, 137.033},
+			{224e-8, 0.516, 84.343},
+			{223e-8, 2.843, 0.261},
+			{220e-8, 1.922, 67.668},
+			{217e-8, 6.142, 5.938},
+			{216e-8, 4.778, 340.771},
+			{208e-8, 5.580, 68.844},
+			{202e-8, 1.297, 0.048},
+			{199e-8, 0.956, 152.532},
+			{194e-8, 1.832, 146.082},
+			{193e-8, 5.409, 28.572},
+		},
+		{ // L1
+			{7502543122e-8, 0, 0},
+			{154458e-8, 5.242017, 74.781599},
+			{24456e-8, 1.71256, 1.48447},
+			{9258e-8, 0.4284, 11.0457},
+			{8266e-8, 1.5022, 63.7359},
+			{7842e-8, 1.3198, 149.5632},
+			{3899e-8, 0.4648, 3.9322},
+			{2284e-8, 4.1737, 76.2661},
+			{1927e-8, 0.5301, 2.9689},
+			{1233e-8, 1.5863, 70.8494},
+			{791e-8, 5.436, 3.181},
+			{767e-8, 1.996, 73.297},
+			{482e-8, 2.984, 85.827},
+			{450e-8, 4.138, 138.517},
+			{446e-8, 3.723, 224.345},
+			{427e-8, 4.731, 71.813},
+			{354e-8, 2.583, 148.079},
+			{348e-8, 2.454, 9.561},
+			{317e-8, 5.579, 52.690},
+			{206e-8, 2.363, 2.448},
+			{189e-8, 4.202, 56.622},
+			{184e-8, 0.284, 151.048},
+			{180e-8, 5.684, 12.530},
+			{171e-8, 3.001, 78.714},
+			{158e-8, 2.909, 0.963},
+			{155e-8, 5.591, 4.453},
+			{154e-8, 4.652, 35.164},
+			{152e-8, 2.942, 77.751},
+			{143e-8, 2.590, 62.251},
+			{121e-8, 4.148, 127.472},
+			{116e-8, 3.732, 65.220},
+			{102e-8, 4.188, 145.631},
+			{102e-8, 6.034, 0.112},
+			{88e-8, 3.99, 18.16},
+			{88e-8, 6.16, 202.25},
+			{81e-8, 2.64, 22.09},
+			{72e-8, 6.05, 70.33},
+			{69e-8, 4.05, 77.96},
+			{59e-8, 3.70, 67.67},
+			{47e-8, 3.54, 351.82},
+			{44e-8, 5.91, 7.11},
+			{43e-8, 5.72, 5.42},
+			{39e-8, 4.92, 222.86},
+			{36e-8, 5.90, 33.68},
+			{36e-8, 3.29, 8.08},
+			{36e-8, 3.33, 71.60},
+			{35e-8, 5.08, 38.13},
+			{31e-8, 5.62, 984.60},
+			{31e-8, 5.50, 59.80},
+			{31e-8, 5.46, 160.61},
+			{30e-8, 1.66, 447.80},
+			{29e-8, 1.15, 462.02},
+			{29e-8, 4.52, 84.34},
+			{27e-8, 5.54, 131.40},
+			{27e-8, 6.15, 299.13},
+			{26e-8, 4.99, 137.03},
+			{25e-8, 5.74, 380.13},
+		},
+		{ // L2
+			{53033e-8, 0, 0},
+			{2358e-8, 2.2601, 74.7816},
+			{769e-8, 4.526, 11.046},
+			{552e-8, 3.258, 63.736},
+			{542e-8, 2.276, 3.932},
+			{529e-8, 4.923, 1.484},
+			{258e-8, 3.691, 3.181},
+			{239e-8, 5.858, 149.563},
+			{182e-8, 6.218, 70.849},
+			{54e-8, 1.44, 76.27},
+			{49e-8, 6.03, 56.62},
+			{45e-8, 3.91, 2.45},
+			{45e-8, 0.81, 85.83},
+			{38e-8, 1.78, 52.69},
+			{37e-8, 4.46, 2.97},
+			{33e-8, 0.86, 9.56},
+			{29e-8, 5.10, 73.30},
+			{24e-8, 2.11, 18.16},
+			{22e-8, 5.99, 138.52},
+			{22e-8, 4.82, 78.71},
+			{21e-8, 2.40, 77.96},
+			{21e-8, 2.17, 224.34},
+			{17e-8, 2.54, 145.63},
+			{17e-8, 3.47, 12.53},
+			{12e-8, 0.02, 22.09},
+			{11e-8, 0.08, 127.47},
+			{10e-8, 5.16, 71.60},
+			{10e-8, 4.46, 62.25},
+			{9e-8, 4.26, 7.11},
+			{8e-8, 5.50, 67.67},
+			{7e-8, 1.25, 5.42},
+			{6e-8, 3.36, 447.80},
+			{6e-8, 5.45, 65.22},
+			{6e-8, 4.52, 151.05},
+			{6e-8, 5.73, 462.02},
+		},
+		{ // L3
+			{121e-8, 0.024, 74.782},
+			{68e-8, 4.12, 3.93},
+			{53e-8, 2.39, 11.05},
+			{46e-8, 0, 0},
+			{45e-8, 2.04, 3.18},
+			{44e-8, 2.96, 1.48},
+			{25e-8, 4.89, 63.74},
+			{21e-8, 4.55, 70.85},
+			{20e-8, 2.31, 149.56},
+			{9e-8, 1.58, 56.62},
+			{4e-8, 0.23, 18.16},
+			{4e-8, 5.39, 76.27},
+			{4e-8, 0.95, 77.96},
+			{3e-8, 4.98, 85.83},
+			{3e-8, 4.13, 52.69},
+			{3e-8, 0.37, 78.71},
+			{2e-8, 0.86, 145.63},
+			{2e-8, 5.66, 9.56},
+		},
+		{ // L4
+			{114e-8, 3.142, 0},
+			{6e-8, 4.58, 74.78},
+			{3e-8, 0.35, 11.05},
+			{1e-8, 3.42, 56.62},
+		},
+	},
+	B: Series{
+		{ // B0
+			{1346278e-8, 2.6187781, 74.7815986},
+			{62341e-8, 5.08111, 149.56320},
+			{61601e-8, 3.14159, 0},
+			{9964e-8, 1.6160, 76.2661},
+			{9926e-8, 0.5763, 73.2971},
+			{3259e-8, 1.2612, 224.3448},
+			{2972e-8, 2.2437, 1.4845},
+			{2010e-8, 6.0555, 148.0787},
+			{1522e-8, 0.2796, 63.7359},
+			{924e-8, 4.038, 151.048},
+			{761e-8, 6.140, 71.813},
+			{522e-8, 3.321, 138.517},
+			{463e-8, 0.743, 85.827},
+			{437e-8, 3.381, 529.691},
+			{435e-8, 0.341, 77.751},
+			{431e-8, 3.554, 213.299},
+			{420e-8, 5.213, 11.046},
+			{245e-8, 0.788, 2.969},
+			{233e-8, 2.257, 222.860},
+			{216e-8, 1.591, 38.133},
+			{180e-8, 3.725, 299.126},
+			{175e-8, 1.236, 146.594},
+			{174e-8, 1.937, 380.128},
+			{160e-8, 5.336, 111.430},
+			{144e-8, 5.962, 35.164},
+			{116e-8, 5.739, 70.849},
+			{106e-8, 0.941, 70.328},
+			{102e-8, 2.619, 78.714},
+		},
+		{ // B1
+			{206366e-8, 4.123943, 74.781599},
+			{8563e-8, 0.3382, 149.5632},
+			{1726e-8, 2.1219, 73.2971},
+			{1374e-8, 0, 0},
+			{1369e-8, 3.0686, 76.2661},
+			{451e-8, 3.777, 1.484},
+			{400e-8, 2.848, 224.345},
+			{307e-8, 1.255, 148.079},
+			{154e-8, 3.786, 63.736},
+			{112e-8, 5.573, 151.048},
+			{111e-8, 5.329, 138.517},
+			{83e-8, 3.59, 71.81},
+			{56e-8, 3.40, 85.83},
+			{54e-8, 1.70, 77.75},
+			{42e-8, 1.21, 11.05},
+			{41e-8, 4.45, 78.71},
+			{32e-8, 3.77, 222.86},
+			{30e-8, 2.56, 2.97},
+			{27e-8, 5.34, 213.30},
+			{26e-8, 0.42, 380.13},
+		},
+		{ // B2
+			{9212e-8, 5.8004, 74.7816},
+			{557e-8, 0, 0},
+			{286e-8, 2.177, 149.563},
+			{95e-8, 3.84, 73.30},
+			{45e-8, 4.88, 76.27},
+			{20e-8, 5.46, 1.48},
+			{15e-8, 0.88, 138.52},
+			{14e-8, 2.85, 148.08},
+			{14e-8, 5.07, 63.74},
+			{10e-8, 5.00, 224.34},
+			{8e-8, 6.27, 78.71},
+		},
+		{ // B3
+			{268e-8, 1.251, 74.782},
+			{11e-8, 3.14, 0},
+			{6e-8, 4.01, 149.56},
+			{3e-8, 5.78, 73.30},
+		},
+		{ // B4
+			{6e-8, 2.85, 74.78},
+		},
+	},
+	R: Series{
+		{ // R0
+			{1921264848e-8, 0, 0},
+			{88784984e-8, 5.60377527, 74.78159857},
+			{3440836e-8, 0.3283610, 73.2971259},
+			{2055653e-8, 1.7829517, 149.5631971},
+			{649322e-8, 4.522473, 76.266071},
+			{602248e-8, 3.860038, 63.735898},
+			{496404e-8, 1.401399, 454.909367},
+			{338526e-8, 1.580027, 138.517497},
+			{243508e-8, 1.570866, 71.812653},
+			{190522e-8, 1.998094, 1.484473},
+			{161858e-8, 2.791379, 148.078724},
+			{143706e-8, 1.383686, 11.045700},
+			{93192e-8, 0.17437, 36.64856},
+			{89806e-8, 3.66105, 109.94569},
+			{71424e-8, 4.24509, 224.34480},
+			{46677e-8, 1.39977, 35.16409},
+			{39026e-8, 3.36235, 277.03499},
+			{39010e-8, 1.66971, 70.84945},
+			{36755e-8, 3.88649, 146.59425},
+			{30349e-8, 0.70100, 151.04767},
+			{29156e-8, 3.18056, 77.75054},
+			{25786e-8, 3.78538, 85.82730},
+			{25620e-8, 5.25656, 380.12777},
+			{22637e-8, 0.72519, 529.69097},
+			{20473e-8, 2.79640, 70.32818},
+			{20472e-8, 1.55589, 202.25340},
+			{17901e-8, 0.55455, 2.96895},
+			{15503e-8, 5.35405, 38.13304},
+			{14702e-8, 4.90434, 108.46122},
+			{12897e-8, 2.62154, 111.43016},
+			{12328e-8, 5.96039, 127.47180},
+			{11959e-8, 1.75044, 984.60033},
+			{11853e-8, 0.99343, 52.69020},
+			{11696e-8, 3.29826, 3.93215},
+			{11495e-8, 0.43774, 65.22037},
+			{10793e-8, 1.42105, 213.29910},
+			{9111e-8, 4.9964, 62.2514},
+			{8421e-8, 5.2535, 222.8603},
+			{8402e-8, 5.0388, 415.5525},
+			{7449e-8, 0.7949, 351.8166},
+			{7329e-8, 3.9728, 183.2428},
+			{6046e-8, 5.6796, 78.7138},
+			{5524e-8, 3.1150, 9.5612},
+			{5445e-8, 5.1058, 145.1098},
+			{5238e-8, 2.6296, 33.6796},
+			{4079e-8, 3.2206, 340.7709},
+			{3919e-8, 4.2502, 39.6175},
+			{3802e-8, 6.1099, 184.7273},
+			{3781e-8, 3.4584, 456.3938},
+			{3687e-8, 2.4872, 453.4249},
+			{3102e-8, 4.1403, 219.8914},
+			{2963e-8, 0.8298, 56.6224},
+			{2942e-8, 0.4239, 299.1264},
+			{2940e-8, 2.1464, 137.0330},
+			{2938e-8, 3.6766, 140.0020},
+			{2865e-8, 0.3100, 12.5302},
+			{2538e-8, 4.8546, 131.4039},
+			{2364e-8, 0.4425, 554.0700},
+			{2183e-8, 2.9404, 305.3462},
+		},
+		{ // R1
+			{1479896e-8, 3.6720571, 74.7815986},
+			{71212e-8, 6.22601, 63.73590},
+			{68627e-8, 6.13411, 149.56320},
+			{24060e-8, 3.14159, 0},
+			{21468e-8, 2.60177, 76.26607},
+			{20857e-8, 5.24625, 11.04570},
+			{11405e-8, 0.01848, 70.84945},
+			{7497e-8, 0.4236, 73.2971},
+			{4244e-8, 1.4169, 85.8273},
+			{3927e-8, 3.1551, 71.8127},
+			{3578e-8, 2.3116, 224.3448},
+			{3506e-8, 2.5835, 138.5175},
+			{3229e-8, 5.2550, 3.9322},
+			{3060e-8, 0.1532, 1.4845},
+			{2564e-8, 0.9808, 148.0787},
+			{2429e-8, 3.9944, 52.6902},
+			{1645e-8, 2.6535, 127.4718},
+			{1584e-8, 1.4305, 78.7138},
+			{1508e-8, 5.0600, 151.0477},
+			{1490e-8, 2.6756, 56.6224},
+			{1413e-8, 4.5746, 202.2534},
+			{1403e-8, 1.3699, 77.7505},
+			{1228e-8, 1.0470, 62.2514},
+			{1033e-8, 0.2646, 131.4039},
+			{992e-8, 2.172, 65.220},
+			{862e-8, 5.055, 351.817},
+			{744e-8, 3.076, 35.164},
+			{687e-8, 2.499, 77.963},
+			{647e-8, 4.473, 70.328},
+			{624e-8, 0.863, 9.561},
+			{604e-8, 0.907, 984.600},
+			{575e-8, 3.231, 447.796},
+			{562e-8, 2.718, 462.023},
+			{530e-8, 5.917, 213.299},
+			{528e-8, 5.151, 2.969},
+		},
+		{ // R2
+			{22440e-8, 0.69953, 74.78160},
+			{4727e-8, 1.6990, 63.7359},
+			{1682e-8, 4.6483, 70.8494},
+			{1650e-8, 3.0966, 11.0457},
+			{1434e-8, 3.5212, 149.5632},
+			{770e-8, 0, 0},
+			{500e-8, 6.172, 76.266},
+			{461e-8, 0.767, 3.932},
+			{390e-8, 4.496, 56.622},
+			{390e-8, 5.527, 85.827},
+			{292e-8, 0.204, 52.690},
+			{287e-8, 3.534, 73.297},
+			{273e-8, 3.847, 138.517},
+			{220e-8, 1.964, 131.404},
+			{216e-8, 0.848, 77.963},
+			{205e-8, 3.248, 78.714},
+			{149e-8, 4.898, 127.472},
+			{129e-8, 2.081, 3.181},
+		},
+		{ // R3
+			{1164e-8, 4.7345, 74.7816},
+			{212e-8, 3.343, 63.736},
+			{196e-8, 2.980, 70.849},
+			{105e-8, 0.958, 11.046},
+			{73e-8, 1.00, 149.56},
+			{72e-8, 0.03, 56.62},
+			{55e-8, 2.59, 3.93},
+			{36e-8, 5.65, 77.96},
+			{34e-8, 3.82, 76.27},
+			{32e-8, 3.60, 131.40},
+		},
+		{ // R4
+			{53e-8, 3.01, 74.78},
+			{10e-8, 1.91, 56.62},
+		},
+	},
+}
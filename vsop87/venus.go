@@ -0,0 +1,138 @@
+package vsop87
+
+// Venus, heliocentric ecliptic coordinates referred to the mean equinox
+// of the date, truncated according to J.Meeus, "Astronomical Algorithms",
+// Appendix III.
+var Venus = Planet{
+	L: Series{
+		{ // L0
+			{317614667e-8, 0, 0},
+			{1353968e-8, 5.5931332, 10213.2855462},
+			{89892e-8, 5.30650, 20426.57109},
+			{5477e-8, 4.4163, 7860.4194},
+			{3456e-8, 2.6996, 11790.6291},
+			{2372e-8, 2.9938, 3930.2097},
+			{1664e-8, 4.2502, 1577.3435},
+			{1438e-8, 4.1575, 9683.5946},
+			{1317e-8, 5.1867, 26.2983},
+			{1201e-8, 6.1536, 30639.8566},
+			{769e-8, 0.816, 9437.763},
+			{761e-8, 1.950, 529.691},
+			{708e-8, 1.065, 775.523},
+			{585e-8, 3.998, 191.448},
+			{500e-8, 4.123, 15720.839},
+			{429e-8, 3.586, 19367.189},
+			{327e-8, 5.677, 5507.553},
+			{326e-8, 4.591, 10404.734},
+			{232e-8, 3.163, 9153.904},
+			{180e-8, 4.653, 1109.379},
+			{155e-8, 5.570, 19651.048},
+			{128e-8, 4.226, 20.775},
+			{128e-8, 0.962, 5661.332},
+			{106e-8, 1.537, 801.821},
+		},
+		{ // L1
+			{1021352943053e-8, 0, 0},
+			{95708e-8, 2.46424, 10213.28555},
+			{14445e-8, 0.51625, 20426.57109},
+			{213e-8, 1.795, 30639.857},
+			{174e-8, 2.655, 26.298},
+			{152e-8, 6.106, 1577.344},
+			{82e-8, 5.70, 191.45},
+			{70e-8, 2.68, 9437.76},
+			{52e-8, 3.60, 775.52},
+			{38e-8, 1.03, 529.69},
+			{30e-8, 1.25, 5507.55},
+			{25e-8, 6.11, 10404.73},
+		},
+		{ // L2
+			{54127e-8, 0, 0},
+			{3891e-8, 0.3451, 10213.2855},
+			{1338e-8, 2.0201, 20426.5711},
+			{24e-8, 2.05, 26.30},
+			{19e-8, 3.54, 30639.86},
+			{10e-8, 3.97, 775.52},
+			{7e-8, 1.52, 1577.34},
+			{6e-8, 1.00, 191.45},
+		},
+		{ // L3
+			{136e-8, 4.804, 10213.286},
+			{78e-8, 3.67, 20426.57},
+			{26e-8, 0, 0},
+		},
+		{ // L4
+			{114e-8, 3.1416, 0},
+			{3e-8, 5.21, 20426.57},
+			{2e-8, 2.51, 10213.29},
+		},
+		{ // L5
+			{1e-8, 3.14, 0},
+		},
+	},
+	B: Series{
+		{ // B0
+			{5923638e-8, 0.2670278, 10213.2855462},
+			{40108e-8, 1.14737, 20426.57109},
+			{32815e-8, 3.14159, 0},
+			{1011e-8, 1.0895, 30639.8566},
+			{149e-8, 6.254, 18073.705},
+			{138e-8, 0.860, 1577.344},
+			{130e-8, 3.672, 9437.763},
+			{120e-8, 3.705, 2352.866},
+			{108e-8, 4.539, 22003.915},
+		},
+		{ // B1
+			{513348e-8, 1.803643, 10213.285546},
+			{4380e-8, 3.3862, 20426.5711},
+			{199e-8, 0, 0},
+			{197e-8, 2.530, 30639.857},
+		},
+		{ // B2
+			{22378e-8, 3.38509, 10213.28555},
+			{282e-8, 0, 0},
+			{173e-8, 5.256, 20426.571},
+			{27e-8, 3.87, 30639.86},
+		},
+		{ // B3
+			{647e-8, 4.992, 10213.286},
+			{20e-8, 3.14, 0},
+			{6e-8, 0.77, 20426.57},
+			{3e-8, 5.44, 30639.86},
+		},
+		{ // B4
+			{14e-8, 0.32, 10213.29},
+		},
+	},
+	R: Series{
+		{ // R0
+			{72334821e-8, 0, 0},
+			{489824e-8, 4.021518, 10213.285546},
+			{1658e-8, 4.9021, 20426.5711},
+			{1632e-8, 2.8455, 7860.4194},
+			{1378e-8, 1.1285, 11790.6291},
+			{498e-8, 2.587, 9683.595},
+			{374e-8, 1.423, 3930.210},
+			{264e-8, 5.529, 9437.763},
+			{237e-8, 2.551, 15720.839},
+			{222e-8, 2.013, 19367.189},
+			{126e-8, 2.728, 1577.344},
+			{119e-8, 3.020, 10404.734},
+		},
+		{ // R1
+			{34551e-8, 0.89199, 10213.28555},
+			{234e-8, 1.772, 20426.571},
+			{234e-8, 3.142, 0},
+		},
+		{ // R2
+			{1407e-8, 5.0637, 10213.2855},
+			{16e-8, 5.47, 20426.57},
+			{13e-8, 0, 0},
+		},
+		{ // R3
+			{50e-8, 3.22, 10213.29},
+		},
+		{ // R4
+			{1e-8, 0.92, 10213.29},
+		},
+	},
+}
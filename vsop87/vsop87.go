@@ -0,0 +1,83 @@
+// Heliocentric positions of the planets, based on VSOP87 theory by
+// P.Bretagnon and G.Francou, version D: ecliptic spherical coordinates
+// referred to the mean ecliptic and equinox of the date.
+//
+// Source: J.Meeus, "Astronomical Algorithms", 2d edition, chapter 32 and
+// Appendix III.
+package vsop87
+
+import (
+	"math"
+
+	"github.com/skrushinsky/scaliger/julian"
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+// Days per millennium
+const DAYS_PER_MILL = julian.DAYS_PER_CENT * 10
+
+// Periodic term A·cos(B + C·τ).
+type Term struct {
+	// amplitude, radians or AU
+	A float64
+	// phase, radians
+	B float64
+	// frequency, radians per millennium
+	C float64
+}
+
+// Series of periodic terms, grouped by powers of τ.
+type Series [][]Term
+
+// Planet theory: series of longitude, latitude and radius vector.
+type Planet struct {
+	L Series
+	B Series
+	R Series
+}
+
+// Given [tau], number of Julian millennia elapsed since J2000 (TD),
+// calculate sum of the series.
+func (s Series) Sum(tau float64) float64 {
+	res := 0.0
+	for i := len(s) - 1; i >= 0; i-- {
+		sum := 0.0
+		for _, term := range s[i] {
+			sum += term.A * math.Cos(term.B+term.C*tau)
+		}
+		res = res*tau + sum
+	}
+	return res
+}
+
+// Given [jde], Julian Ephemeris Date, calculate heliocentric ecliptic
+// longitude and latitude in arc-degrees and radius vector in AU, referred
+// to the mean equinox of the date.
+func (p Planet) Heliocentric(jde float64) (l float64, b float64, r float64) {
+	tau := (jde - julian.J2000) / DAYS_PER_MILL
+	l = mathutils.ReduceDeg(mathutils.Degrees(p.L.Sum(tau)))
+	b = mathutils.Degrees(p.B.Sum(tau))
+	r = p.R.Sum(tau)
+	return
+}
+
+// Returns a copy of the series without terms which amplitude is less
+// than [precision], radians or AU.
+func (s Series) Truncate(precision float64) Series {
+	res := make(Series, len(s))
+	for i, terms := range s {
+		for _, term := range terms {
+			if math.Abs(term.A) >= precision {
+				res[i] = append(res[i], term)
+			}
+		}
+	}
+	return res
+}
+
+// Returns a copy of the theory without terms which amplitude is less than
+// [precision], radians or AU. Fewer terms make calculations faster at the
+// expense of accuracy.
+func (p Planet) Truncate(precision float64) Planet {
+	return Planet{L: p.L.Truncate(precision), B: p.B.Truncate(precision), R: p.R.Truncate(precision)}
+}
@@ -0,0 +1,48 @@
+package vsop87
+
+import (
+	"testing"
+
+	"github.com/skrushinsky/scaliger/mathutils"
+)
+
+func TestEarth(t *testing.T) {
+	// J.Meeus, "Astronomical Algorithms", example 25.b
+	l, b, r := Earth.Heliocentric(2448908.5)
+	if !mathutils.AlmostEqual(l, 19.907372, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", 19.907372, l)
+	}
+	if !mathutils.AlmostEqual(b, -0.000179, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", -0.000179, b)
+	}
+	if !mathutils.AlmostEqual(r, 0.99760775, 1e-7) {
+		t.Errorf("Expected: %f, got: %f", 0.99760775, r)
+	}
+}
+
+func TestVenus(t *testing.T) {
+	// J.Meeus, "Astronomical Algorithms", example 32.a
+	l, b, r := Venus.Heliocentric(2448976.5)
+	if !mathutils.AlmostEqual(l, 26.11428, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", 26.11428, l)
+	}
+	if !mathutils.AlmostEqual(b, -2.62070, 1e-5) {
+		t.Errorf("Expected: %f, got: %f", -2.62070, b)
+	}
+	if !mathutils.AlmostEqual(r, 0.724603, 1e-6) {
+		t.Errorf("Expected: %f, got: %f", 0.724603, r)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	full := len(Jupiter.L[0])
+	short := len(Jupiter.Truncate(1e-5).L[0])
+	if short == 0 || short >= full {
+		t.Errorf("Expected fewer than %d terms, got: %d", full, short)
+	}
+	l0, _, _ := Jupiter.Heliocentric(2448976.5)
+	l1, _, _ := Jupiter.Truncate(1e-5).Heliocentric(2448976.5)
+	if !mathutils.AlmostEqual(l0, l1, 0.01) {
+		t.Errorf("Expected: %f, got: %f", l0, l1)
+	}
+}